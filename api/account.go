@@ -5,18 +5,11 @@ import (
 	"time"
 
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/valyala/fasthttp"
 )
 
-var (
-	// Verified can be used to identify if the instance has been verified yet
-	Verified = false
-
-	// random 6-digit code which is used to verify the instance
-	expectedVerifyCode = ""
-)
-
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
@@ -32,25 +25,34 @@ func (a *API) RegisterAccount(ctx *fasthttp.RequestCtx) {
 
 	// validate
 	if err := req.Validate(); err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Title:   "Validation for input failed",
-			Details: err.Error(),
-		})
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
 		return
 	}
 
-	// in reality only 1 code can be requested at a time
-	if expectedVerifyCode == "" {
-		expectedVerifyCode = generateRandomCode(6)
-		a.Log.Warn("GENERATED VERIFY CODE", "code", expectedVerifyCode)
+	// a number with two-step verification can only be registered again with its PIN
+	if pinHash := a.Config.Current().TwoStepPinHash; pinHash != nil {
+		if req.Pin == "" {
+			returnErrorCode(ctx, model.ErrRequiredParameterMissing, "The two-step verification PIN is required")
+			return
+		}
+		if bcrypt.CompareHashAndPassword(pinHash, []byte(req.Pin)) != nil {
+			logger.Warn("Unable to register account", "error", "two-step verification PIN mismatch")
+			returnErrorCode(ctx, model.ErrAccessDenied, "The two-step verification PIN is invalid")
+			return
+		}
+	}
+
+	// the code is sent to the fake phone and can be read from its inbox
+	if err := a.Registration.RequestCode(req); err != nil {
+		logger.Warn("Unable to register account", "error", err)
+		returnAPIError(ctx, err)
+		return
 	}
 
 	resp := &model.MetaResponse{
 		Meta: AcquireMeta(),
 	}
 	defer ReleaseMeta(resp.Meta)
-	ctx.Response.Header.Set("verify-code", expectedVerifyCode)
 	returnJSON(ctx, 202, resp)
 }
 
@@ -63,18 +65,74 @@ func (a *API) VerifyAccount(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	if req.Code != expectedVerifyCode {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: "Wrong verification code",
-			Title:   "Client Error",
-			Href:    "",
-		})
+	if err := a.Registration.Verify(req.Code); err != nil {
+		logger.Warn("Unable to verify account", "error", err)
+		returnAPIError(ctx, err)
 		return
 	}
 
 	a.Log.Info("Successfully verified account")
-	Verified = true
-	expectedVerifyCode = "" // reset code
 	ctx.SetStatusCode(201)
 }
+
+// GetRegistrationInbox godoc
+// @Summary Read the inbox of the fake phone
+// @Description Get the registration codes which have been sent via sms or voice
+// @Tags mock
+// @Produce json
+// @Success 200 {object} model.RegistrationInbox
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/account/inbox [get]
+// @Security ControlAuth
+func (a *API) GetRegistrationInbox(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Registration.Inbox())
+}
+
+// SetTwoStepPin godoc
+// @Summary Enable two-step verification
+// @Description Set the 6-digit PIN which is required to register the number again
+// @Tags settings
+// @Consume json
+// @Param body body model.TwoStepRequest true "the PIN"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /settings/account/two-step [post]
+// @Security BearerAuth
+func (a *API) SetTwoStepPin(ctx *fasthttp.RequestCtx) {
+	req := new(model.TwoStepRequest)
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, req); err != nil {
+		logger.Warn("Unable to set two-step verification PIN", "error", err)
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Pin), PasswordCost)
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		cfg.TwoStepPinHash = hash
+		return nil
+	}); !ok {
+		return
+	}
+	a.Log.Info("Enabled two-step verification")
+}
+
+// DisableTwoStepPin godoc
+// @Summary Disable two-step verification
+// @Tags settings
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /settings/account/two-step [delete]
+// @Security BearerAuth
+func (a *API) DisableTwoStepPin(ctx *fasthttp.RequestCtx) {
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		cfg.TwoStepPinHash = nil
+		return nil
+	}); !ok {
+		return
+	}
+	a.Log.Info("Disabled two-step verification")
+}
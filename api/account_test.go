@@ -0,0 +1,92 @@
+package api_test
+
+import (
+	"bytes"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Account API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	do := func(method, path, body string) *http.Response {
+		req, _ := http.NewRequest(method, baseUrl+path, bytes.NewBufferString(body))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+		return resp
+	}
+
+	errorCode := func(resp *http.Response) int32 {
+		errResp := new(model.ErrorResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+		return errResp.Errors[0].Code
+	}
+
+	Context("Register with two-step verification", func() {
+		invalidResp := do("POST", "/settings/account/two-step", `{"pin": "12345"}`)
+		setResp := do("POST", "/settings/account/two-step", `{"pin": "123456"}`)
+
+		missingResp := do("POST", "/account", `{"cc": "49", "phone_number": "1701223123", "method": "sms", "cert": "abc"}`)
+		wrongResp := do("POST", "/account", `{"cc": "49", "phone_number": "1701223123", "method": "sms", "cert": "abc", "pin": "654321"}`)
+		validResp := do("POST", "/account", `{"cc": "49", "phone_number": "1701223123", "method": "sms", "cert": "abc", "pin": "123456"}`)
+
+		disableResp := do("DELETE", "/settings/account/two-step", "")
+		cooldownResp := do("POST", "/account", `{"cc": "49", "phone_number": "1701223123", "method": "sms", "cert": "abc"}`)
+
+		It("Should validate the PIN", func() {
+			Expect(invalidResp.StatusCode).To(Equal(400))
+			Expect(setResp.StatusCode).To(Equal(200))
+		})
+
+		It("Should require the PIN", func() {
+			Expect(missingResp.StatusCode).To(Equal(400))
+			Expect(errorCode(missingResp)).To(Equal(model.ErrRequiredParameterMissing.Code))
+
+			Expect(wrongResp.StatusCode).To(Equal(403))
+			Expect(errorCode(wrongResp)).To(Equal(model.ErrAccessDenied.Code))
+
+			Expect(validResp.StatusCode).To(Equal(202))
+		})
+
+		It("Should disable two-step verification", func() {
+			Expect(disableResp.StatusCode).To(Equal(200))
+			Expect(api.Config.Current().TwoStepPinHash).To(BeNil())
+		})
+
+		It("Should enforce the cooldown of code requests", func() {
+			Expect(cooldownResp.StatusCode).To(Equal(429))
+		})
+	})
+
+	Context("Verify with the code of the fake phone", func() {
+		blockedResp := do("POST", "/messages", `{"to": "491701223123", "type": "text", "text": {"body": "Hello"}}`)
+
+		inboxResp, err := client.Do(NewControlRequest("GET", "/account/inbox", nil))
+		PanicIfNotNil(err)
+		inbox := new(model.RegistrationInbox)
+		PanicIfNotNil(unmarsheler.Unmarshal(inboxResp.Body, inbox))
+		code := inbox.Codes[len(inbox.Codes)-1].Code
+
+		wrongResp := do("POST", "/account/verify", `{"code": "abcdef"}`)
+		validResp := do("POST", "/account/verify", `{"code": "`+code+`"}`)
+
+		It("Should block messages until the account is registered", func() {
+			Expect(blockedResp.StatusCode).To(Equal(503))
+		})
+
+		It("Should register the account", func() {
+			Expect(inbox.Codes[len(inbox.Codes)-1].PhoneNumber).To(Equal("1701223123"))
+			Expect(wrongResp.StatusCode).To(Equal(400))
+			Expect(validResp.StatusCode).To(Equal(201))
+			Expect(api.Registration.Registered()).To(BeTrue())
+			Expect(api.Config.Current().Verified).To(BeTrue())
+		})
+	})
+})
@@ -3,7 +3,16 @@ package api_test
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"math/big"
 	"net"
 	"net/http"
 	"os"
@@ -11,6 +20,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
@@ -18,6 +28,7 @@ import (
 	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttputil"
+	"golang.org/x/crypto/bcrypt"
 
 	log "github.com/ron96G/go-common-utils/log"
 )
@@ -26,10 +37,11 @@ var (
 	staticAPIToken = "abcdefg"
 	apiPrefix      = "/v1"
 	baseUrl        = "http://localhost:8080" + apiPrefix
+	controlUrl     = "http://localhost:8080" + w_api.ControlPrefix
 	contacts       = []*model.Contact{}
 	generators, _  = model.NewGenerators(w_api.Config.UploadDir, contacts, w_api.Config.InboundMedia)
 	w              = webhook.NewWebhook(w_api.Config.ApplicationSettings.Webhooks.Url, w_api.Config.Version, generators)
-	api            = w_api.NewAPI(apiPrefix, staticAPIToken, uint(20), w_api.Config, w)
+	api            = registered(w_api.NewAPI(apiPrefix, staticAPIToken, uint(20), fastHashes(w_api.Config), w))
 	client         = StartNewServer(api.Server)
 
 	marsheler = jsonpb.Marshaler{
@@ -53,12 +65,60 @@ func TestAPI(t *testing.T) {
 	RunSpecs(t, "API Suite")
 }
 
+// fastHashes lowers the bcrypt cost of passwords and PINs before the first API is created.
+// Otherwise, the hashes exceed the timeout of the client with the race detector.
+func fastHashes(cfg *model.InternalConfig) *model.InternalConfig {
+	w_api.PasswordCost = bcrypt.MinCost
+	return cfg
+}
+
+// registered completes the registration of the account so that messages can be sent
+func registered(a *w_api.API) *w_api.API {
+	_, err := a.Config.Update(func(cfg *model.InternalConfig) error {
+		cfg.Registration = &model.Registration{State: model.Registration_registered}
+		return nil
+	})
+	PanicIfNotNil(err)
+	return a
+}
+
+// selfSignedCert returns a new PEM encoded self-signed certificate and its private key
+func selfSignedCert(commonName string) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	PanicIfNotNil(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	PanicIfNotNil(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	PanicIfNotNil(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
 func PanicIfNotNil(err error) {
 	if err != nil {
 		panic(err)
 	}
 }
 
+// NewControlRequest creates a request of a control endpoint which is authorized with the API key
+func NewControlRequest(method, path string, body io.Reader) *http.Request {
+	req, err := http.NewRequest(method, controlUrl+path, body)
+	PanicIfNotNil(err)
+	req.Header.Set(w_api.ControlAPIKeyHeader, staticAPIToken)
+	return req
+}
+
 func StartNewServer(s *fasthttp.Server) (client *http.Client) {
 	ln := fasthttputil.NewInmemoryListener()
 
@@ -119,8 +179,31 @@ var _ = Describe("Users API", func() {
 				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
 
 				Expect(errResp.Errors).To(HaveLen(1))
-				Expect(errResp.Errors[0].Code).To(Equal(int32(401)))
-				Expect(errResp.Errors[0].Title).To(Equal("Client Error"))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
+				Expect(errResp.Errors[0].Title).To(Equal(model.ErrAccessDenied.Title))
+				Expect(errResp.Errors[0].Details).To(Equal("Missing Authorization"))
+			})
+		})
+
+		buf.Reset()
+
+		Context("Malformed Authorization Header", func() {
+			marsheler.Marshal(buf, &requestBody)
+			req, _ := http.NewRequest("POST", baseUrl+"/users/login", buf)
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin")))
+			resp, err := client.Do(req)
+			PanicIfNotNil(err)
+
+			It("Should have status code 401", func() {
+				Expect(resp.StatusCode).To(Equal(401))
+			})
+
+			It("Should have an error response body", func() {
+				errResp := new(model.ErrorResponse)
+				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+
+				Expect(errResp.Errors).To(HaveLen(1))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
 				Expect(errResp.Errors[0].Details).To(Equal("Missing Authorization"))
 			})
 		})
@@ -144,8 +227,30 @@ var _ = Describe("Users API", func() {
 				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
 
 				Expect(errResp.Errors).To(HaveLen(1))
-				Expect(errResp.Errors[0].Code).To(Equal(int32(401)))
-				Expect(errResp.Errors[0].Title).To(Equal("Client Error"))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
+				Expect(errResp.Errors[0].Title).To(Equal(model.ErrAccessDenied.Title))
+				Expect(errResp.Errors[0].Details).To(Equal("Username or password is invalid"))
+			})
+		})
+
+		buf.Reset()
+
+		Context("Unknown User", func() {
+			marsheler.Marshal(buf, &requestBody)
+			req, _ := http.NewRequest("POST", baseUrl+"/users/login", buf)
+			req.SetBasicAuth("unknown", "secret")
+
+			resp, err := client.Do(req)
+			PanicIfNotNil(err)
+
+			It("Should not be distinguishable from an incorrect password", func() {
+				Expect(resp.StatusCode).To(Equal(401))
+
+				errResp := new(model.ErrorResponse)
+				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+
+				Expect(errResp.Errors).To(HaveLen(1))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
 				Expect(errResp.Errors[0].Details).To(Equal("Username or password is invalid"))
 			})
 		})
@@ -168,8 +273,8 @@ var _ = Describe("Users API", func() {
 				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
 
 				Expect(errResp.Errors).To(HaveLen(1))
-				Expect(errResp.Errors[0].Code).To(Equal(int32(400)))
-				Expect(errResp.Errors[0].Title).To(Equal("Client Error"))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
+				Expect(errResp.Errors[0].Title).To(Equal(model.ErrAccessDenied.Title))
 				Expect(errResp.Errors[0].Details).To(Equal("Password change required"))
 			})
 		})
@@ -269,7 +374,7 @@ var _ = Describe("Users API", func() {
 		username := "username"
 		requestBody := &model.User{
 			Username: username,
-			Password: "password",
+			Password: "Password123!",
 		}
 
 		buf.Reset()
@@ -301,8 +406,8 @@ var _ = Describe("Users API", func() {
 			resp, err := client.Do(req)
 			PanicIfNotNil(err)
 
-			It("Should have status code 400", func() {
-				Expect(resp.StatusCode).To(Equal(400))
+			It("Should have status code 409", func() {
+				Expect(resp.StatusCode).To(Equal(409))
 			})
 
 			It("Should have an error response body", func() {
@@ -310,8 +415,8 @@ var _ = Describe("Users API", func() {
 				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
 
 				Expect(errResp.Errors).To(HaveLen(1))
-				Expect(errResp.Errors[0].Code).To(Equal(int32(400)))
-				Expect(errResp.Errors[0].Title).To(Equal("User already exists"))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrResourceAlreadyExists.Code))
+				Expect(errResp.Errors[0].Title).To(Equal(model.ErrResourceAlreadyExists.Title))
 				Expect(errResp.Errors[0].Details).To(Equal(fmt.Sprintf("The requested user %s already exists", username)))
 			})
 		})
@@ -348,11 +453,76 @@ var _ = Describe("Users API", func() {
 				PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
 
 				Expect(errResp.Errors).To(HaveLen(1))
-				Expect(errResp.Errors[0].Code).To(Equal(int32(404)))
-				Expect(errResp.Errors[0].Title).To(Equal("Client Error"))
+				Expect(errResp.Errors[0].Code).To(Equal(model.ErrResourceNotFound.Code))
+				Expect(errResp.Errors[0].Title).To(Equal(model.ErrResourceNotFound.Title))
 				Expect(errResp.Errors[0].Details).To(Equal(fmt.Sprintf("Could not find user with name %s", username)))
 			})
 		})
 	})
 
+	Context("Managing Users", func() {
+
+		adminAuthToken, err := api.GenerateToken("admin", "ADMIN")
+		PanicIfNotNil(err)
+		userAuthToken, err := api.GenerateToken("operator", "USER")
+		PanicIfNotNil(err)
+
+		do := func(method, path, token string, body proto.Message) *http.Response {
+			buf := bytes.NewBuffer(nil)
+			if body != nil {
+				marsheler.Marshal(buf, body)
+			}
+			req, _ := http.NewRequest(method, baseUrl+path, buf)
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp, err := client.Do(req)
+			PanicIfNotNil(err)
+			return resp
+		}
+
+		weakResp := do("POST", "/users", adminAuthToken, &model.User{Username: "operator", Password: "password"})
+		createResp := do("POST", "/users", adminAuthToken, &model.User{Username: "operator", Password: "Operator123!"})
+
+		users := &model.UserList{}
+		listResp := do("GET", "/users", adminAuthToken, nil)
+		PanicIfNotNil(unmarsheler.Unmarshal(listResp.Body, users))
+
+		forbiddenResp := do("GET", "/users/admin", userAuthToken, nil)
+		promoteResp := do("PUT", "/users/operator", userAuthToken, &model.UpdateUserRequest{Role: model.User_ADMIN})
+		ownResp := do("PUT", "/users/operator", userAuthToken, &model.UpdateUserRequest{Password: "Changed123!"})
+		revokedResp := do("GET", "/users/operator", userAuthToken, nil)
+
+		updated := &model.UserInfo{}
+		updateResp := do("PUT", "/users/operator", adminAuthToken, &model.UpdateUserRequest{Role: model.User_ADMIN})
+		PanicIfNotNil(unmarsheler.Unmarshal(updateResp.Body, updated))
+		record := proto.Clone(api.Config.Current().UserRecords["operator"]).(*model.UserRecord)
+		do("DELETE", "/users/operator", adminAuthToken, nil)
+
+		It("Should enforce the password policy", func() {
+			Expect(weakResp.StatusCode).To(Equal(400))
+			Expect(createResp.StatusCode).To(Equal(201))
+		})
+
+		It("Should list the users without passwords", func() {
+			Expect(listResp.StatusCode).To(Equal(200))
+			Expect(users.Users).To(ContainElement(&model.UserInfo{Username: "admin", Role: model.User_ADMIN,
+				Created: users.Users[0].Created, PasswordChanged: users.Users[0].PasswordChanged}))
+		})
+
+		It("Should only allow users to change their own password", func() {
+			Expect(forbiddenResp.StatusCode).To(Equal(403))
+			Expect(ownResp.StatusCode).To(Equal(200))
+			Expect(promoteResp.StatusCode).To(Equal(403))
+		})
+
+		It("Should revoke the tokens of the user once the password is changed", func() {
+			Expect(revokedResp.StatusCode).To(Equal(401))
+		})
+
+		It("Should change the role and keep the hashed password", func() {
+			Expect(updateResp.StatusCode).To(Equal(200))
+			Expect(updated.Role).To(Equal(model.User_ADMIN))
+			Expect(bcrypt.CompareHashAndPassword(record.PasswordHash, []byte("Changed123!"))).To(Succeed())
+		})
+	})
+
 }) // Users API
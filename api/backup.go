@@ -0,0 +1,449 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+const (
+	// BackupVersion is the version of the archive which is created by a backup.
+	// Archives of older versions can be restored. Backups without a version only contain the config.
+	// Tokens are not backed up. The tokens of older archives are ignored, so that revoked sessions stay revoked.
+	BackupVersion = 1
+
+	backupManifestFile = "manifest.json"
+	backupConfigFile   = "config.json"
+	backupMediaPrefix  = "media/" // metadata of the uploaded media
+	backupFilesPrefix  = "files/" // files of the upload directory
+)
+
+// backup is the content of a backup archive
+type backup struct {
+	Manifest *model.BackupManifest
+	Config   *model.InternalConfig
+	Media    map[string]*model.MediaMetadata
+	Files    map[string][]byte
+}
+
+// createBackup collects the state of the API. The uploaded media and the inbound media of the generators
+// are only contained if includeMedia is set, the profile photo only if includeProfilePhoto is set.
+func (a *API) createBackup(includeMedia, includeProfilePhoto bool) (*backup, error) {
+	cfg := a.Config.Current()
+	b := &backup{
+		Manifest: &model.BackupManifest{
+			Version:       BackupVersion,
+			Created:       time.Now().Unix(),
+			ServerVersion: Version,
+		},
+		Config: cfg.InternalConfig,
+		Media:  map[string]*model.MediaMetadata{},
+		Files:  map[string][]byte{},
+	}
+
+	addFile := func(name string) error {
+		data, err := ioutil.ReadFile(filepath.Join(cfg.UploadDir, name))
+		if err == nil {
+			b.Files[name] = data
+		}
+		return err
+	}
+
+	if includeMedia {
+		keys, err := a.Store.Keys(MediaStatePrefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			metadata := &model.MediaMetadata{}
+			if _, err = a.Store.Get(key, metadata); err != nil {
+				return nil, err
+			}
+			if err = addFile(metadata.Id); os.IsNotExist(err) {
+				a.Log.Warn("Skipping missing media file", "id", metadata.Id)
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			b.Media[metadata.Id] = metadata
+		}
+		for _, name := range cfg.InboundMedia {
+			if err = addFile(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if includeProfilePhoto && cfg.ProfilePhotoFilename != "" {
+		if err := addFile(cfg.ProfilePhotoFilename); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	for name := range b.Files {
+		b.Manifest.Files = append(b.Manifest.Files, name)
+	}
+	sort.Strings(b.Manifest.Files)
+	return b, nil
+}
+
+// write writes the backup as tar archive
+func (b *backup) write(w io.Writer) error {
+	tw := tar.NewWriter(w)
+	now := time.Unix(b.Manifest.Created, 0)
+
+	writeEntry := func(name string, data []byte) error {
+		hdr := &tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+	writeMessage := func(name string, m proto.Message) error {
+		buf := &bytes.Buffer{}
+		if err := marsheler.Marshal(buf, m); err != nil {
+			return err
+		}
+		return writeEntry(name, buf.Bytes())
+	}
+
+	if err := writeMessage(backupManifestFile, b.Manifest); err != nil {
+		return err
+	}
+	if err := writeMessage(backupConfigFile, b.Config); err != nil {
+		return err
+	}
+	for id, metadata := range b.Media {
+		if err := writeMessage(backupMediaPrefix+id+".json", metadata); err != nil {
+			return err
+		}
+	}
+	for _, name := range b.Manifest.Files {
+		if err := writeEntry(backupFilesPrefix+name, b.Files[name]); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// readBackup parses the archive of a backup. Backups without a version are the JSON encoded config.
+func readBackup(data []byte) (*backup, error) {
+	b := &backup{
+		Media: map[string]*model.MediaMetadata{},
+		Files: map[string][]byte{},
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		b.Manifest = &model.BackupManifest{}
+		b.Config = &model.InternalConfig{}
+		if err := unmarsheler.Unmarshal(bytes.NewReader(trimmed), b.Config); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid backup archive: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		readMessage := func(m proto.Message) error {
+			if err := unmarsheler.Unmarshal(bytes.NewReader(content), m); err != nil {
+				return fmt.Errorf("invalid backup entry %s: %v", hdr.Name, err)
+			}
+			return nil
+		}
+
+		switch name := hdr.Name; {
+		case name == backupManifestFile:
+			b.Manifest = &model.BackupManifest{}
+			err = readMessage(b.Manifest)
+		case name == backupConfigFile:
+			b.Config = &model.InternalConfig{}
+			err = readMessage(b.Config)
+		case strings.HasPrefix(name, backupMediaPrefix):
+			metadata := &model.MediaMetadata{}
+			if err = readMessage(metadata); err == nil {
+				b.Media[metadata.Id] = metadata
+			}
+		case strings.HasPrefix(name, backupFilesPrefix):
+			name = strings.TrimPrefix(name, backupFilesPrefix)
+			if !validFileName(name) {
+				return nil, fmt.Errorf("invalid file name %s in backup", name)
+			}
+			b.Files[name] = content
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Manifest == nil || b.Config == nil {
+		return nil, fmt.Errorf("backup does not contain a manifest and config")
+	}
+	if b.Manifest.Version > BackupVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", b.Manifest.Version)
+	}
+	for id := range b.Media {
+		if !validFileName(id) || b.Files[id] == nil {
+			return nil, fmt.Errorf("backup does not contain the media file %s", id)
+		}
+	}
+	return b, nil
+}
+
+// validFileName checks that the file is located inside of the upload directory
+func validFileName(name string) bool {
+	return name != "" &&
+		path.Clean(name) == name &&
+		!path.IsAbs(name) &&
+		!strings.HasPrefix(name, "..") &&
+		!strings.HasPrefix(name, ".restore-")
+}
+
+// restoreBackup validates the backup and replaces the config and media of the API.
+// The tokens of users which are changed or removed by the backup are revoked.
+// Either all or none of them are changed. The webhook, its client and the generators are reconfigured.
+// If dryRun is set, only the changes are returned.
+func (a *API) restoreBackup(b *backup, dryRun bool) (*model.RestoreResponse, error) {
+	current := a.Config.Current()
+	next := b.Config
+
+	// the fields of the server are not restored
+	next.Version = current.Version
+	next.Status = current.Status
+	next.UploadDir = current.UploadDir
+	next.Tenants = current.Tenants
+	// the plaintext passwords of older backups replace the current users
+	if err := MigrateUsers(next, nil); err != nil {
+		return nil, model.ErrParameterValueInvalid.Err(err.Error())
+	}
+	if err := a.checkConfig(next); err != nil {
+		return nil, model.ErrParameterValueInvalid.Err(err.Error())
+	}
+	for _, name := range next.InboundMedia {
+		if _, ok := b.Files[name]; ok {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(current.UploadDir, name)); err != nil {
+			return nil, model.ErrParameterValueInvalid.Err(fmt.Sprintf("The inbound media %s is neither contained in the backup nor uploaded", name))
+		}
+	}
+
+	changes, err := diffBackupConfig(current.InternalConfig, next)
+	if err != nil {
+		return nil, err
+	}
+	resp := &model.RestoreResponse{
+		Media:  int32(len(b.Media)),
+		DryRun: dryRun,
+	}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &model.RestoreResponse_Change{
+			Field: c.Field,
+			Old:   jsonString(c.Old),
+			New:   jsonString(c.New),
+		})
+	}
+	for name := range b.Files {
+		resp.Files = append(resp.Files, name)
+	}
+	sort.Strings(resp.Files)
+	revoked := changedUsers(current.UserRecords, next.UserRecords)
+	for _, session := range a.Tokens.Sessions().Sessions {
+		if contains(revoked, session.User) {
+			resp.Tokens++
+		}
+	}
+	if dryRun {
+		return resp, nil
+	}
+
+	commitFiles, rollbackFiles, err := replaceFiles(current.UploadDir, b.Files)
+	if err != nil {
+		return nil, err
+	}
+	replacedMedia := map[string]*model.MediaMetadata{}
+	rollback := func() {
+		rollbackFiles()
+		for id, metadata := range replacedMedia {
+			if metadata != nil {
+				_ = a.Store.Put(MediaStatePrefix+id, metadata)
+			} else {
+				_ = a.Store.Delete(MediaStatePrefix + id)
+			}
+		}
+	}
+
+	generators, err := model.NewGenerators(current.UploadDir, ContactsFromConfig(next), next.InboundMedia)
+	if err != nil {
+		rollback()
+		return nil, model.ErrParameterValueInvalid.Err(err.Error())
+	}
+	for id, metadata := range b.Media {
+		previous := &model.MediaMetadata{}
+		if found, _ := a.Store.Get(MediaStatePrefix+id, previous); !found {
+			previous = nil
+		}
+		replacedMedia[id] = previous
+		if err = a.Store.Put(MediaStatePrefix+id, metadata); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+	if _, err = a.Config.Replace(next); err != nil {
+		rollback()
+		return nil, err
+	}
+
+	commitFiles()
+	a.revokeUsers(revoked)
+	a.Webhook.SetGenerators(generators)
+	a.Log.Info("Restored backup", "version", b.Manifest.Version, "changes", len(changes), "files", len(b.Files))
+	return resp, nil
+}
+
+// replaceFiles writes the files into the directory. The replaced files are kept
+// until commit is called so that rollback can restore the previous files.
+func replaceFiles(dir string, files map[string][]byte) (commit, rollback func(), err error) {
+	staging, err := ioutil.TempDir(dir, ".restore-")
+	if err != nil {
+		return nil, nil, err
+	}
+	commit = func() { _ = os.RemoveAll(staging) }
+
+	for name, data := range files {
+		fpath := filepath.Join(staging, "new", filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(fpath), 0700); err == nil {
+			err = ioutil.WriteFile(fpath, data, 0600)
+		}
+		if err != nil {
+			commit()
+			return nil, nil, err
+		}
+	}
+
+	type replaced struct {
+		name    string
+		existed bool
+	}
+	done := []replaced{}
+	rollback = func() {
+		for i := len(done) - 1; i >= 0; i-- {
+			target := filepath.Join(dir, filepath.FromSlash(done[i].name))
+			if done[i].existed {
+				_ = os.Rename(filepath.Join(staging, "old", filepath.FromSlash(done[i].name)), target)
+			} else {
+				_ = os.Remove(target)
+			}
+		}
+		commit()
+	}
+
+	for name := range files {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		r := replaced{name: name}
+		if _, err = os.Stat(target); err == nil {
+			old := filepath.Join(staging, "old", filepath.FromSlash(name))
+			if err = os.MkdirAll(filepath.Dir(old), 0700); err == nil {
+				err = os.Rename(target, old)
+			}
+			if err != nil {
+				rollback()
+				return nil, nil, err
+			}
+			r.existed = true
+		}
+		done = append(done, r)
+		if err = os.MkdirAll(filepath.Dir(target), 0700); err == nil {
+			err = os.Rename(filepath.Join(staging, "new", filepath.FromSlash(name)), target)
+		}
+		if err != nil {
+			rollback()
+			return nil, nil, err
+		}
+	}
+	return commit, rollback, nil
+}
+
+// diffBackupConfig returns the changes of all fields of the config.
+// Passwords are masked and secrets are replaced by a fingerprint.
+func diffBackupConfig(old, next *model.InternalConfig) ([]ConfigChange, error) {
+	mask := func(cfg *model.InternalConfig) *model.InternalConfig {
+		cfg = proto.Clone(cfg).(*model.InternalConfig)
+		cfg.Users = nil
+		cfg.UserRecords = nil
+		cfg.TwoStepPinHash = fingerprint(cfg.TwoStepPinHash)
+		cfg.WebhookClientKey = fingerprint(cfg.WebhookClientKey)
+		for _, target := range cfg.WebhookTargets {
+			target.ClientKey = fingerprint(target.ClientKey)
+		}
+		return cfg
+	}
+
+	o, err := toJSONValue(mask(old))
+	if err != nil {
+		return nil, err
+	}
+	n, err := toJSONValue(mask(next))
+	if err != nil {
+		return nil, err
+	}
+	changes := []ConfigChange{}
+	for field, value := range n.(map[string]interface{}) {
+		diffValues(field, o.(map[string]interface{})[field], value, &changes)
+	}
+	diffUsers(old.UserRecords, next.UserRecords, &changes)
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+func fingerprint(secret []byte) []byte {
+	if len(secret) == 0 {
+		return nil
+	}
+	sum := sha256.Sum256(secret)
+	return sum[:8]
+}
+
+// jsonString encodes a decoded JSON value. Missing values are returned as empty string.
+func jsonString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
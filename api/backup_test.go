@@ -0,0 +1,178 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/util"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ = Describe("Backup API", func() {
+	defer GinkgoRecover()
+
+	uploadDir, err := ioutil.TempDir("", "backup")
+	PanicIfNotNil(err)
+	PanicIfNotNil(ioutil.WriteFile(filepath.Join(uploadDir, "image.jpg"), []byte("image"), 0600))
+
+	cfg := w_api.NewConfig()
+	cfg.UploadDir = uploadDir + "/"
+	cfg.Users["admin"] = "secret"
+	cfg.Contacts = []*model.InternalContact{{Id: "491701223123", Name: "Peter P."}}
+	cfg.InboundMedia = map[string]string{"image": "image.jpg"}
+	cfg.ApplicationSettings.Webhooks.Url = "https://localhost:9000/webhook"
+	wh := webhook.NewWebhook(cfg.ApplicationSettings.Webhooks.Url, cfg.Version, generators)
+	backupAPI := w_api.NewAPI(apiPrefix, staticAPIToken, uint(20), cfg, wh)
+
+	call := func(handler fasthttp.RequestHandler, body []byte) *fasthttp.RequestCtx {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetContentType("text/plain")
+		ctx.Request.SetBody(body)
+		handler(ctx)
+		return ctx
+	}
+	marshal := func(m proto.Message) []byte {
+		buf := &bytes.Buffer{}
+		PanicIfNotNil(marsheler.Marshal(buf, m))
+		return buf.Bytes()
+	}
+
+	mediaResp := &model.IdResponse{}
+	PanicIfNotNil(unmarsheler.Unmarshal(bytes.NewReader(call(backupAPI.SaveMedia, []byte("media")).Response.Body()), mediaResp))
+	mediaID := mediaResp.Media[0].Id
+	token, err := backupAPI.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	backupResp := &model.BackupResponse{}
+	backupCtx := call(backupAPI.BackupSettings, []byte(`{"password": "password123", "include_media": true}`))
+	PanicIfNotNil(unmarsheler.Unmarshal(bytes.NewReader(backupCtx.Response.Body()), backupResp))
+
+	// change the state after the backup
+	_, err = backupAPI.Config.Update(func(cfg *model.InternalConfig) error {
+		cfg.ApplicationSettings.Webhooks.Url = "https://example.com/webhook"
+		cfg.UserRecords["user"] = &model.UserRecord{Role: model.User_USER}
+		return nil
+	})
+	PanicIfNotNil(err)
+	PanicIfNotNil(os.Remove(filepath.Join(uploadDir, mediaID)))
+	PanicIfNotNil(backupAPI.Tokens.Revoke(token))
+	userToken, err := backupAPI.GenerateToken("user", w_api.RoleUser)
+	PanicIfNotNil(err)
+
+	restore := func(dryRun bool) (*fasthttp.RequestCtx, *model.RestoreResponse) {
+		ctx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{
+			Password: "password123",
+			Data:     backupResp.Settings.Data,
+			DryRun:   dryRun,
+		}))
+		resp := &model.RestoreResponse{}
+		_ = unmarsheler.Unmarshal(bytes.NewReader(ctx.Response.Body()), resp)
+		return ctx, resp
+	}
+
+	Context("Restore a backup with dry run", func() {
+		ctx, resp := restore(true)
+		fields := []string{}
+		for _, c := range resp.Changes {
+			fields = append(fields, c.Field)
+		}
+		users := backupAPI.Config.Current().UserRecords
+		_, statErr := os.Stat(filepath.Join(uploadDir, mediaID))
+		_, userTokenErr := backupAPI.Tokens.Validate(userToken)
+
+		It("Should report the changes", func() {
+			Expect(ctx.Response.StatusCode()).To(Equal(200))
+			Expect(fields).To(ContainElements("applicationSettings.webhooks.url", "users.user"))
+			Expect(resp.Files).To(ConsistOf("image.jpg", mediaID))
+			Expect(resp.Tokens).To(Equal(int32(1)))
+		})
+
+		It("Should not change the state", func() {
+			Expect(users).To(HaveKey("user"))
+			Expect(os.IsNotExist(statErr)).To(BeTrue())
+			Expect(userTokenErr).To(BeNil())
+		})
+	})
+
+	Context("Restore a backup", func() {
+		ctx, _ := restore(false)
+		users := backupAPI.Config.Current().UserRecords
+		_, tokenErr := backupAPI.Tokens.Validate(token)
+		_, userTokenErr := backupAPI.Tokens.Validate(userToken)
+		media, _ := ioutil.ReadFile(filepath.Join(uploadDir, mediaID))
+
+		It("Should restore the config and media", func() {
+			Expect(ctx.Response.StatusCode()).To(Equal(200))
+			Expect(users).ToNot(HaveKey("user"))
+			Expect(media).To(Equal([]byte("media")))
+		})
+
+		It("Should not restore revoked tokens and revoke the tokens of removed users", func() {
+			Expect(tokenErr).ToNot(BeNil())
+			Expect(userTokenErr).ToNot(BeNil())
+		})
+
+		It("Should reconfigure the webhook", func() {
+			Expect(wh.Default().URL()).To(Equal("https://localhost:9000/webhook"))
+		})
+	})
+
+	Context("Restore a backup without version", func() {
+		// backups without envelope are encrypted with the SHA-256 hash of the password as key
+		block, err := aes.NewCipher(util.Sha256Hash([]byte("password123")))
+		PanicIfNotNil(err)
+		gcm, err := cipher.NewGCM(block)
+		PanicIfNotNil(err)
+		nonce := make([]byte, gcm.NonceSize())
+		legacy := gcm.Seal(nonce, nonce, []byte(`{"users": {"admin": "legacy"}, "applicationSettings": {}, `+
+			`"contacts": [{"id": "491701223123", "name": "Peter P."}], "inboundMedia": {"image": "image.jpg"}}`), nil)
+		ctx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{Password: "password123", Data: legacy}))
+		os.RemoveAll(uploadDir)
+		admin := backupAPI.Config.Current().UserRecords["admin"]
+
+		It("Should restore the config and hash the passwords", func() {
+			Expect(ctx.Response.StatusCode()).To(Equal(200))
+			Expect(admin.Role).To(Equal(model.User_ADMIN))
+			Expect(bcrypt.CompareHashAndPassword(admin.PasswordHash, []byte("legacy"))).To(Succeed())
+		})
+	})
+
+	Context("Restore a truncated backup", func() {
+		ctx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{Password: "password123", Data: []byte("short")}))
+		wrongCtx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{Password: "wrong", Data: backupResp.Settings.Data}))
+
+		It("Should reject the backup", func() {
+			Expect(ctx.Response.StatusCode()).To(Equal(400))
+			Expect(wrongCtx.Response.StatusCode()).To(Equal(400))
+		})
+	})
+
+	Context("Restore a backup with excessive key derivation parameters", func() {
+		envelope := func(saltLen byte, memory uint32) []byte {
+			header := append([]byte("WAENC"), 1, 1, saltLen)
+			header = append(header, make([]byte, saltLen)...)
+			header = append(header, 0, 0, 0, 3, byte(memory>>24), byte(memory>>16), byte(memory>>8), byte(memory), 4, 12)
+			return append(header, make([]byte, 12+32)...)
+		}
+		memoryCtx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{Password: "password123", Data: envelope(16, 1024*1024)}))
+		saltCtx := call(backupAPI.RestoreSettings, marshal(&model.RestoreRequest{Password: "password123", Data: envelope(0, 64*1024)}))
+
+		It("Should reject the backup", func() {
+			Expect(memoryCtx.Response.StatusCode()).To(Equal(400))
+			Expect(string(memoryCtx.Response.Body())).To(ContainSubstring("invalid key derivation parameters"))
+			Expect(saltCtx.Response.StatusCode()).To(Equal(400))
+			Expect(string(saltCtx.Response.Body())).To(ContainSubstring("invalid salt size"))
+		})
+	})
+})
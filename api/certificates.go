@@ -1,50 +1,115 @@
 package api
 
 import (
+	"bytes"
 	"crypto/tls"
-	"crypto/x509"
-	"time"
+	"encoding/pem"
+	"strings"
 
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
 	"github.com/ron96G/whatsapp-bizapi-mock/util"
 	"github.com/valyala/fasthttp"
 )
 
+// UploadWebhookCA godoc
+// @Summary Upload the CA certificate for the webhook
+// @Description Upload a PEM encoded CA certificate which is used to validate the certificate of the webhook
+// @Tags settings
+// @Param file body string true "the PEM encoded CA certificate"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /certificates/webhooks/ca [post]
+// @Security BearerAuth
 func (a *API) UploadWebhookCA(ctx *fasthttp.RequestCtx) {
 
-	uploadedCert := ctx.PostBody() //  this should be the CA
-	caCertPool := x509.NewCertPool()
+	uploadedCert := append([]byte(nil), ctx.PostBody()...) //  this should be the CA
 
-	if !caCertPool.AppendCertsFromPEM(uploadedCert) {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Title:   "Unable to parse request body",
-			Details: "Failed to parse uploaded certificate",
-		})
+	// the client of the webhook is replaced once the config has been changed
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		if _, err := newWebhookClient(uploadedCert, cfg.WebhookClientCert, cfg.WebhookClientKey); err != nil {
+			return err
+		}
+		cfg.WebhookCA = uploadedCert
+		return nil
+	}); !ok {
 		return
 	}
 
-	tlsConfig := &tls.Config{
-		RootCAs:            caCertPool,
-		InsecureSkipVerify: false,
-		MinVersion:         tls.VersionTLS12,
+	ctx.SetStatusCode(200)
+}
+
+// UploadWebhookClientCert godoc
+// @Summary Upload the client certificate for the webhook
+// @Description Upload a PEM encoded client certificate and its private key which are used for mutual TLS with the webhook
+// @Tags settings
+// @Param file body string true "the PEM encoded certificate and private key"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /certificates/webhooks/client [post]
+// @Security BearerAuth
+func (a *API) UploadWebhookClientCert(ctx *fasthttp.RequestCtx) {
+	clientCert, clientKey := splitPEM(ctx.PostBody())
+	if clientCert == nil || clientKey == nil {
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, "Request body must contain a PEM encoded certificate and private key")
+		return
 	}
 
-	// overwrite the current default client
-	// this will be propagated to the webhook
-	util.DefaultClient = &fasthttp.Client{
-		NoDefaultUserAgentHeader:      true,
-		DisablePathNormalizing:        false,
-		DisableHeaderNamesNormalizing: false,
-		ReadTimeout:                   5 * time.Second,
-		WriteTimeout:                  5 * time.Second,
-		TLSConfig:                     tlsConfig,
-		MaxConnsPerHost:               8,
-		MaxIdleConnDuration:           30 * time.Second,
-		MaxConnDuration:               0, // unlimited
-		MaxIdemponentCallAttempts:     2,
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		if _, err := newWebhookClient(cfg.WebhookCA, clientCert, clientKey); err != nil {
+			return err
+		}
+		cfg.WebhookClientCert = clientCert
+		cfg.WebhookClientKey = clientKey
+		return nil
+	}); !ok {
+		return
 	}
-	a.Config.WebhookCA = uploadedCert
 
 	ctx.SetStatusCode(200)
 }
+
+// newWebhookClient returns a new client which uses the provided certificates
+func newWebhookClient(rootCa, clientCert, clientKey []byte) (*fasthttp.Client, error) {
+	insecureSkipVerify := rootCa == nil && util.DefaultClient().TLSConfig.InsecureSkipVerify
+	client, err := util.NewTLSClient(rootCa, clientCert, clientKey, insecureSkipVerify)
+	if err != nil {
+		return nil, model.ErrParameterValueInvalid.Err(err.Error())
+	}
+	return client, nil
+}
+
+// renewWebhookClient replaces the client of the default webhook target if the certificates of the config have been changed.
+// Each API has its own client, so that the certificates of a tenant are not used by the others.
+func (a *API) renewWebhookClient(previous, current *ConfigSnapshot) {
+	if bytes.Equal(previous.WebhookCA, current.WebhookCA) &&
+		bytes.Equal(previous.WebhookClientCert, current.WebhookClientCert) &&
+		bytes.Equal(previous.WebhookClientKey, current.WebhookClientKey) {
+		return
+	}
+	client, err := newWebhookClient(current.WebhookCA, current.WebhookClientCert, current.WebhookClientKey)
+	if err != nil {
+		a.Log.Error("Unable to renew webhook client", "error", err)
+		return
+	}
+	a.Webhook.Default().SetClient(client)
+}
+
+// splitPEM separates the certificates and the private key of the PEM encoded input
+func splitPEM(in []byte) (certs []byte, key []byte) {
+	for {
+		var block *pem.Block
+		block, in = pem.Decode(in)
+		if block == nil {
+			break
+		}
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			key = pem.EncodeToMemory(block)
+		} else {
+			certs = append(certs, pem.EncodeToMemory(block)...)
+		}
+	}
+	if _, err := tls.X509KeyPair(certs, key); err != nil {
+		return nil, nil
+	}
+	return certs, key
+}
@@ -0,0 +1,86 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var _ = Describe("Certificates API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	upload := func(body []byte) (*http.Response, *model.ErrorResponse) {
+		req, _ := http.NewRequest("POST", baseUrl+"/certificates/webhooks/client", bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+		errResp := new(model.ErrorResponse)
+		if resp.StatusCode != 200 {
+			PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+		}
+		return resp, errResp
+	}
+
+	Context("Upload a client certificate without private key", func() {
+		cert, _ := selfSignedCert("webhook-client")
+		resp, errResp := upload(cert)
+
+		It("Should be rejected", func() {
+			Expect(resp.StatusCode).To(Equal(400))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrParameterValueInvalid.Code))
+		})
+	})
+
+	Context("Upload a client certificate with the key of another certificate", func() {
+		cert, _ := selfSignedCert("webhook-client")
+		_, otherKey := selfSignedCert("other")
+		resp, errResp := upload(append(cert, otherKey...))
+
+		It("Should be rejected", func() {
+			Expect(resp.StatusCode).To(Equal(400))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrParameterValueInvalid.Code))
+		})
+	})
+
+	Context("Upload a client certificate and its private key", func() {
+		cert, key := selfSignedCert("webhook-client")
+		resp, _ := upload(append(cert, key...))
+
+		// the receiver of the webhook requires a client certificate
+		presented := make(chan string, 1)
+		receiver := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			presented <- r.TLS.PeerCertificates[0].Subject.CommonName
+		}))
+		receiver.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		receiver.StartTLS()
+		defer receiver.Close()
+
+		req := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(req)
+		webhookResp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(webhookResp)
+		req.SetRequestURI(receiver.URL + "/webhook")
+		webhookErr := api.Webhook.Default().Client().Do(req, webhookResp)
+
+		It("Should store the certificate", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(api.Config.Current().WebhookClientCert).To(Equal(cert))
+			Expect(api.Config.Current().WebhookClientKey).To(Equal(key))
+		})
+
+		It("Should present the certificate to the webhook", func() {
+			Expect(webhookErr).To(BeNil())
+			Expect(webhookResp.StatusCode()).To(Equal(200))
+			Expect(presented).To(Receive(Equal("webhook-client")))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+// SetWebhookChaos godoc
+// @Summary Configure the chaos mode of the webhook
+// @Description Duplicate, reorder, delay, split or drop webhook requests to test the idempotency of the receiver
+// @Tags mock
+// @Consume json
+// @Produce json
+// @Param body body model.WebhookChaos true "the chaos configuration"
+// @Success 200 {object} model.WebhookChaos
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/webhook/chaos [post]
+// @Security ControlAuth
+func (a *API) SetWebhookChaos(ctx *fasthttp.RequestCtx) {
+	cfg := &model.WebhookChaos{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, cfg); err != nil {
+		logger.Warn("Unable to set webhook chaos", "error", err)
+		return
+	}
+
+	if cfg.MaxLatencyMs > 0 && cfg.MaxLatencyMs < cfg.MinLatencyMs {
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, "maxLatencyMs must be greater than or equal to minLatencyMs")
+		return
+	}
+
+	if _, ok := a.updateConfig(ctx, func(c *model.InternalConfig) error {
+		c.WebhookChaos = cfg
+		return nil
+	}); !ok {
+		return
+	}
+	a.Log.Info("Updated webhook chaos", "enabled", cfg.Enabled)
+	returnJSON(ctx, 200, cfg)
+}
+
+// GetWebhookChaos godoc
+// @Summary Get the chaos mode of the webhook
+// @Tags mock
+// @Produce json
+// @Success 200 {object} model.WebhookChaos
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/webhook/chaos [get]
+// @Security ControlAuth
+func (a *API) GetWebhookChaos(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Webhook.Chaos.Get())
+}
+
+// updateWebhookChaos applies the chaos configuration once it has been changed
+func (a *API) updateWebhookChaos(previous, current *ConfigSnapshot) {
+	if !proto.Equal(previous.WebhookChaos, current.WebhookChaos) {
+		a.Webhook.Chaos.Set(current.WebhookChaos)
+	}
+}
@@ -1,11 +1,27 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"gopkg.in/yaml.v2"
 )
 
+// ConfigEnvPrefix is the prefix of the environment variables which override fields of the config.
+// The path of the field follows in upper case, e.g. WA_CFG_APPLICATIONSETTINGS_WEBHOOKS_URL.
+// Elements of lists are selected by their index and entries of maps by their lower case key,
+// e.g. WA_CFG_CONTACTS_0_NAME or WA_CFG_USERS_ADMIN. Messages, lists and maps can also be set as JSON.
+const ConfigEnvPrefix = "WA_CFG_"
+
 var (
 	// This is the default config if non is provided on startup
 	// It will be overwritten by a config is provided on startup
@@ -44,6 +60,8 @@ var (
 				Url:                   "https://localhost:9000/webhook",
 				MaxConcurrentRequests: 8,
 			},
+			SentStatus:  true,
+			PassThrough: true,
 		},
 		ProfileAbout:         &model.ProfileAbout{},
 		BusinessProfile:      &model.BusinessProfile{},
@@ -53,9 +71,18 @@ var (
 	}
 )
 
-func InitConfig(r io.Reader) error {
-	Config = NewConfig()
-	return unmarsheler.Unmarshal(r, Config)
+func InitConfig(r io.Reader) (err error) {
+	Config, err = ReadConfig(r)
+	return err
+}
+
+// ReadConfig reads a config. Missing values are set to the defaults of NewConfig
+func ReadConfig(r io.Reader) (*model.InternalConfig, error) {
+	cfg := NewConfig()
+	if err := unmarsheler.Unmarshal(r, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
 }
 
 // NewConfig returns a new InternalConfig object which has some required default values set
@@ -69,6 +96,8 @@ func NewConfig() *model.InternalConfig {
 				Url:                   "",
 				MaxConcurrentRequests: 8,
 			},
+			SentStatus:  true,
+			PassThrough: true,
 		},
 		InboundMedia:    map[string]string{},
 		Contacts:        []*model.InternalContact{},
@@ -77,3 +106,273 @@ func NewConfig() *model.InternalConfig {
 		ProfileAbout:    &model.ProfileAbout{},
 	}
 }
+
+// ReadConfigFile reads a JSON or YAML (.yaml, .yml) config file and applies the overrides of the environment
+func ReadConfigFile(path string) (*model.InternalConfig, error) {
+	data, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if isYAMLFile(path) {
+		if err = yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		doc = fromYAML(doc)
+	} else if len(bytes.TrimSpace(data)) > 0 {
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		if err = d.Decode(&doc); err != nil {
+			return nil, err
+		}
+	}
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("config must be an object")
+	}
+
+	if err = ApplyEnvOverrides(root, os.Environ()); err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(root); err != nil {
+		return nil, err
+	}
+	return ReadConfig(bytes.NewReader(data))
+}
+
+// ApplyConfigEnvOverrides returns a copy of the config with the overrides of the environment.
+// It is used for configs which are not read from a file, e.g. the stored state.
+func ApplyConfigEnvOverrides(cfg *model.InternalConfig, environ []string) (*model.InternalConfig, error) {
+	buf := &bytes.Buffer{}
+	if err := marsheler.Marshal(buf, cfg); err != nil {
+		return nil, err
+	}
+	root := map[string]interface{}{}
+	d := json.NewDecoder(buf)
+	d.UseNumber()
+	if err := d.Decode(&root); err != nil {
+		return nil, err
+	}
+	if err := ApplyEnvOverrides(root, environ); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	next := &model.InternalConfig{}
+	if err = unmarsheler.Unmarshal(bytes.NewReader(data), next); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// SaveConfigFile writes the config as JSON or YAML (.yaml, .yml) file
+func SaveConfigFile(cfg *model.InternalConfig, path string) error {
+	if !isYAMLFile(path) {
+		return SaveToJSONFile(cfg, path)
+	}
+	data, err := MarshalYAML(cfg)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Clean(path), data, 0600)
+}
+
+// MarshalYAML returns the config as YAML with the same field names as the JSON config
+func MarshalYAML(cfg *model.InternalConfig) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	m := marsheler
+	m.EmitDefaults = true
+	if err := m.Marshal(buf, cfg); err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(doc)
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// fromYAML converts the maps of a decoded YAML document so that it can be encoded as JSON
+func fromYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = fromYAML(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = fromYAML(v[i])
+		}
+	}
+	return v
+}
+
+// ApplyEnvOverrides sets the fields of the decoded JSON config doc
+// which are defined by environment variables with the ConfigEnvPrefix
+func ApplyEnvOverrides(doc map[string]interface{}, environ []string) error {
+	for _, kv := range environ {
+		if !strings.HasPrefix(kv, ConfigEnvPrefix) {
+			continue
+		}
+		i := strings.IndexByte(kv, '=')
+		if i < 0 {
+			continue
+		}
+		name, value := kv[:i], kv[i+1:]
+
+		path, leaf, err := resolveConfigPath(reflect.TypeOf(model.InternalConfig{}), strings.Split(name[len(ConfigEnvPrefix):], "_"))
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		val, err := envValue(leaf, value)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		setPath(doc, path, val)
+	}
+	return nil
+}
+
+// configPathElem is the key of an object or the index of a list in a decoded JSON config
+type configPathElem struct {
+	key   string
+	index int
+}
+
+// resolveConfigPath returns the JSON path of the field of t which is named by the tokens.
+// Field names may contain underscores. Therefore, the tokens are joined until they match a field.
+func resolveConfigPath(t reflect.Type, tokens []string) ([]configPathElem, reflect.Type, error) {
+	if len(tokens) == 0 {
+		return nil, t, nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Ptr:
+		return resolveConfigPath(t.Elem(), tokens)
+
+	case t.Kind() == reflect.Struct:
+		var lastErr error
+		for n := 1; n <= len(tokens); n++ {
+			name := strings.Join(tokens[:n], "")
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				fieldName := protoFieldName(f)
+				if fieldName == "" || strings.ToUpper(strings.ReplaceAll(fieldName, "_", "")) != name {
+					continue
+				}
+				path, leaf, err := resolveConfigPath(f.Type, tokens[n:])
+				if err == nil {
+					return append([]configPathElem{{key: fieldName, index: -1}}, path...), leaf, nil
+				}
+				lastErr = err
+			}
+		}
+		if lastErr != nil {
+			return nil, nil, lastErr
+		}
+		return nil, nil, fmt.Errorf("unknown field %s", strings.Join(tokens, "_"))
+
+	case t.Kind() == reflect.Map:
+		if isScalar(t.Elem()) {
+			return []configPathElem{{key: strings.ToLower(strings.Join(tokens, "_")), index: -1}}, t.Elem(), nil
+		}
+		path, leaf, err := resolveConfigPath(t.Elem(), tokens[1:])
+		return append([]configPathElem{{key: strings.ToLower(tokens[0]), index: -1}}, path...), leaf, err
+
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		index, err := strconv.ParseUint(tokens[0], 10, 16)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid index %s", tokens[0])
+		}
+		path, leaf, err := resolveConfigPath(t.Elem(), tokens[1:])
+		return append([]configPathElem{{index: int(index)}}, path...), leaf, err
+	}
+	return nil, nil, fmt.Errorf("unknown field %s", strings.Join(tokens, "_"))
+}
+
+// protoFieldName returns the name of the field in the JSON config
+func protoFieldName(f reflect.StructField) string {
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return opt[len("name="):]
+		}
+	}
+	return ""
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Struct, reflect.Map:
+		return false
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	}
+	return true
+}
+
+// envValue converts the value of an environment variable to the JSON value of a field of type t
+func envValue(t reflect.Type, value string) (interface{}, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return value, nil
+	case reflect.Bool:
+		return strconv.ParseBool(value)
+	case reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			if t.Kind() == reflect.Int32 {
+				// enums are set by their name
+				return value, nil
+			}
+			return nil, fmt.Errorf("invalid number %q", value)
+		}
+		return json.Number(value), nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// bytes are base64 encoded
+			return value, nil
+		}
+	}
+
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(value))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid JSON value: %v", err)
+	}
+	return v, nil
+}
+
+// setPath sets the value in the decoded JSON node. Missing objects and list elements are created.
+func setPath(node interface{}, path []configPathElem, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	elem := path[0]
+	if elem.index >= 0 {
+		list, _ := node.([]interface{})
+		for len(list) <= elem.index {
+			list = append(list, nil)
+		}
+		list[elem.index] = setPath(list[elem.index], path[1:], value)
+		return list
+	}
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+	}
+	m[elem.key] = setPath(m[elem.key], path[1:], value)
+	return m
+}
@@ -0,0 +1,76 @@
+package api_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Config File", func() {
+	defer GinkgoRecover()
+
+	dir, err := ioutil.TempDir("", "config")
+	PanicIfNotNil(err)
+	path := filepath.Join(dir, "config.yaml")
+	PanicIfNotNil(ioutil.WriteFile(path, []byte(`
+uploadDir: media/
+users:
+  admin: secret
+contacts:
+  - id: "491701223123"
+    name: Peter P.
+applicationSettings:
+  webhooks:
+    url: https://localhost:9000/webhook
+`), 0600))
+
+	Context("Read a YAML config with overrides of the environment", func() {
+		os.Setenv("WA_CFG_APPLICATIONSETTINGS_WEBHOOKS_URL", "https://example.com/webhook")
+		os.Setenv("WA_CFG_APPLICATIONSETTINGS_SENT_STATUS", "true")
+		os.Setenv("WA_CFG_CONTACTS_0_NAME", "Peter L.")
+		os.Setenv("WA_CFG_MESSAGINGTIER", "TIER_10K")
+		cfg, err := w_api.ReadConfigFile(path)
+		os.Unsetenv("WA_CFG_APPLICATIONSETTINGS_WEBHOOKS_URL")
+		os.Unsetenv("WA_CFG_APPLICATIONSETTINGS_SENT_STATUS")
+		os.Unsetenv("WA_CFG_CONTACTS_0_NAME")
+		os.Unsetenv("WA_CFG_MESSAGINGTIER")
+		os.RemoveAll(dir)
+
+		It("Should apply the overrides", func() {
+			Expect(err).To(BeNil())
+			Expect(cfg.Users).To(HaveKeyWithValue("admin", "secret"))
+			Expect(cfg.Contacts[0].Id).To(Equal("491701223123"))
+			Expect(cfg.Contacts[0].Name).To(Equal("Peter L."))
+			Expect(cfg.ApplicationSettings.Webhooks.Url).To(Equal("https://example.com/webhook"))
+			Expect(cfg.ApplicationSettings.SentStatus).To(BeTrue())
+			Expect(cfg.MessagingTier).To(Equal(model.MessagingTier_TIER_10K))
+		})
+	})
+
+	Context("Apply the environment to a stored config", func() {
+		stored := w_api.NewConfig()
+		stored.ApplicationSettings.Webhooks.Url = "https://stored.example.com/webhook"
+		stored.UploadDir = "stored/"
+		cfg, err := w_api.ApplyConfigEnvOverrides(stored, []string{"WA_CFG_APPLICATIONSETTINGS_WEBHOOKS_URL=https://example.com/webhook"})
+
+		It("Should override the stored fields", func() {
+			Expect(err).To(BeNil())
+			Expect(cfg.ApplicationSettings.Webhooks.Url).To(Equal("https://example.com/webhook"))
+			Expect(cfg.UploadDir).To(Equal("stored/"))
+			Expect(stored.ApplicationSettings.Webhooks.Url).To(Equal("https://stored.example.com/webhook"))
+		})
+	})
+
+	Context("Override an unknown field", func() {
+		err := w_api.ApplyEnvOverrides(map[string]interface{}{}, []string{"WA_CFG_UNKNOWN_FIELD=1"})
+
+		It("Should return an error", func() {
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
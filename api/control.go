@@ -0,0 +1,78 @@
+package api
+
+import (
+	"crypto/subtle"
+
+	"github.com/fasthttp/router"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// ControlAPIKeyHeader contains the API key of the control endpoints.
+	// Alternatively, the key is accepted as "Authorization: Apikey <key>"
+	ControlAPIKeyHeader = "X-API-Key"
+)
+
+var (
+	// ControlPrefix is the prefix of the control endpoints which only exist in the mock,
+	// e.g. to generate webhook requests or to inject faults. They are not part of the WhatsApp API.
+	ControlPrefix = "/mock"
+	// SeparateControlServer serves the control endpoints only by the ControlServer of the API.
+	// Otherwise, they are also served by the Server next to the WhatsApp API.
+	SeparateControlServer = false
+)
+
+// controlRoutes registers the control endpoints at the ControlPrefix of the router
+func (a *API) controlRoutes(r *router.Router) {
+	ctrlR := r.Group(ControlPrefix)
+
+	ctrlR.POST("/generate", a.AuthorizeControl(Limiter(a.GenerateWebhookRequests, 2)))
+	ctrlR.POST("/generate/cancel", a.AuthorizeControl(a.CancelGenerateWebhookRquests))
+	ctrlR.GET("/webhook/chaos", monitoring.All(a.AuthorizeControl(a.GetWebhookChaos)))
+	ctrlR.POST("/webhook/chaos", monitoring.All(a.AuthorizeControl(a.SetWebhookChaos)))
+	ctrlR.GET("/faults", monitoring.All(a.AuthorizeControl(a.ListFaultRules)))
+	ctrlR.POST("/faults", monitoring.All(a.AuthorizeControl(a.AddFaultRule)))
+	ctrlR.DELETE("/faults", monitoring.All(a.AuthorizeControl(a.ClearFaultRules)))
+	ctrlR.DELETE("/faults/{id}", monitoring.All(a.AuthorizeControl(a.DeleteFaultRule)))
+	ctrlR.POST("/health/gateway", monitoring.All(a.AuthorizeControl(a.SetGatewayConnectivity)))
+	ctrlR.GET("/account/inbox", monitoring.All(a.AuthorizeControl(a.GetRegistrationInbox)))
+	ctrlR.POST("/settings/account/tier", monitoring.All(a.AuthorizeControl(a.SetMessagingTier)))
+	ctrlR.POST("/settings/account/quality", monitoring.All(a.AuthorizeControl(a.SetQualitySignals)))
+
+	ctrlR.GET("/admin/tenants", monitoring.All(a.AuthorizeControl(a.ListTenants)))
+	ctrlR.POST("/admin/tenants", monitoring.All(a.AuthorizeControl(a.CreateTenant)))
+	ctrlR.DELETE("/admin/tenants/{name}", monitoring.All(a.AuthorizeControl(a.DeleteTenant)))
+	ctrlR.GET("/admin/sessions", monitoring.All(a.AuthorizeControl(a.ListSessions)))
+	ctrlR.GET("/admin/lockouts", monitoring.All(a.AuthorizeControl(a.ListLockouts)))
+	ctrlR.DELETE("/admin/lockouts", monitoring.All(a.AuthorizeControl(a.ClearLockouts)))
+}
+
+// AuthorizeControl accepts requests with a client certificate which has been verified by
+// the TLS listener (mTLS) or with the API key of the control endpoints.
+// Without an API key, only verified client certificates are accepted.
+func (a *API) AuthorizeControl(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
+		logger := a.LoggerFromCtx(ctx)
+
+		if state := ctx.TLSConnectionState(); state != nil && len(state.VerifiedChains) > 0 {
+			logger.Info("Successfully authorized client certificate", "subject", state.VerifiedChains[0][0].Subject.String())
+			h(ctx)
+			return
+		}
+
+		key := string(ctx.Request.Header.Peek(ControlAPIKeyHeader))
+		if key == "" {
+			key, _ = extractAuthToken(ctx, "Apikey")
+		}
+		if a.controlAPIKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(a.controlAPIKey)) == 1 {
+			logger.Info("Successfully authorized user with apikey")
+			h(ctx)
+			return
+		}
+
+		logger.Warn("Failed to authorize control request", "reason", "invalid apikey and no verified client certificate")
+		returnError(ctx, 401, model.ErrAccessDenied.New("Invalid apikey"))
+	})
+}
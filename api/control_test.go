@@ -0,0 +1,70 @@
+package api_test
+
+import (
+	"bytes"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var _ = Describe("Control API", func() {
+	defer GinkgoRecover()
+
+	Context("Generate webhook requests without an API key", func() {
+		req := NewControlRequest("POST", "/generate", bytes.NewBufferString(`{}`))
+		req.Header.Del("X-API-Key")
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		errResp := new(model.ErrorResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+
+		It("Should be rejected", func() {
+			Expect(resp.StatusCode).To(Equal(401))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
+		})
+	})
+
+	Context("Use the API key as authorization header", func() {
+		req := NewControlRequest("GET", "/webhook/chaos", nil)
+		req.Header.Del("X-API-Key")
+		req.Header.Set("Authorization", "Apikey "+staticAPIToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		It("Should be allowed", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+		})
+	})
+
+	Context("Generate webhook requests next to the WhatsApp API", func() {
+		req, _ := http.NewRequest("POST", baseUrl+"/generate", bytes.NewBufferString(`{}`))
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		It("Should not be found", func() {
+			Expect(resp.StatusCode).To(Equal(404))
+		})
+	})
+
+	Context("Access the control server", func() {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod("GET")
+		ctx.Request.SetRequestURI(controlUrl + "/faults")
+		ctx.Request.Header.Set("X-API-Key", staticAPIToken)
+		api.ControlServer.Handler(ctx)
+
+		apiCtx := &fasthttp.RequestCtx{}
+		apiCtx.Request.Header.SetMethod("GET")
+		apiCtx.Request.SetRequestURI(baseUrl + "/settings/application")
+		api.ControlServer.Handler(apiCtx)
+
+		It("Should only serve the control endpoints", func() {
+			Expect(ctx.Response.StatusCode()).To(Equal(200))
+			Expect(apiCtx.Response.StatusCode()).To(Equal(404))
+		})
+	})
+})
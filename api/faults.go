@@ -0,0 +1,308 @@
+package api
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+
+	log "github.com/ron96G/go-common-utils/log"
+)
+
+type faultRule struct {
+	cfg           *model.FaultRule
+	path          *regexp.Regexp
+	headerPattern *regexp.Regexp
+	body          *regexp.Regexp
+}
+
+// FaultInjector injects errors, latency and dropped connections into the API requests
+// which match one of its rules. The first matching rule is applied.
+type FaultInjector struct {
+	rules []*faultRule
+	Log   log.Logger
+	mux   sync.Mutex
+}
+
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		rules: []*faultRule{},
+		Log:   log.New("fault_logger", "component", "faults"),
+	}
+}
+
+// Add validates the rule and appends it to the rules. The rule is returned with its assigned id.
+func (f *FaultInjector) Add(cfg *model.FaultRule) (*model.FaultRule, error) {
+	var err error
+	rule := &faultRule{cfg: proto.Clone(cfg).(*model.FaultRule)}
+	rule.cfg.Id = uuid.New().String()
+	rule.cfg.Hits = 0
+
+	if cfg.ErrorCode != 0 {
+		if _, ok := model.LookupErrorCode(cfg.ErrorCode); !ok {
+			return nil, fmt.Errorf("unknown error code %d", cfg.ErrorCode)
+		}
+	}
+	if cfg.StatusCode != 0 && (cfg.StatusCode < 100 || cfg.StatusCode > 599) {
+		return nil, fmt.Errorf("invalid status code %d", cfg.StatusCode)
+	}
+	if cfg.HeaderPattern != "" && cfg.Header == "" {
+		return nil, fmt.Errorf("headerPattern requires header")
+	}
+	if rule.path, err = compileOptional(cfg.Path); err != nil {
+		return nil, fmt.Errorf("invalid path: %v", err)
+	}
+	if rule.headerPattern, err = compileOptional(cfg.HeaderPattern); err != nil {
+		return nil, fmt.Errorf("invalid headerPattern: %v", err)
+	}
+	if rule.body, err = compileOptional(cfg.Body); err != nil {
+		return nil, fmt.Errorf("invalid body: %v", err)
+	}
+
+	f.mux.Lock()
+	f.rules = append(f.rules, rule)
+	f.mux.Unlock()
+	return proto.Clone(rule.cfg).(*model.FaultRule), nil
+}
+
+// Remove deletes the rule with the given id
+func (f *FaultInjector) Remove(id string) bool {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	for i, rule := range f.rules {
+		if rule.cfg.Id == id {
+			f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FaultInjector) Clear() {
+	f.mux.Lock()
+	f.rules = []*faultRule{}
+	f.mux.Unlock()
+}
+
+// List returns a copy of all rules including the number of injected faults
+func (f *FaultInjector) List() *model.FaultRules {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	list := &model.FaultRules{Rules: make([]*model.FaultRule, len(f.rules))}
+	for i, rule := range f.rules {
+		list.Rules[i] = proto.Clone(rule.cfg).(*model.FaultRule)
+	}
+	return list
+}
+
+// match returns a copy of the first rule which matches the request
+// and whose fault is injected according to its probability and count
+func (f *FaultInjector) match(ctx *fasthttp.RequestCtx) *model.FaultRule {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for _, rule := range f.rules {
+		cfg := rule.cfg
+		if cfg.Count > 0 && cfg.Hits >= cfg.Count {
+			continue
+		}
+		if cfg.Method != "" && !strings.EqualFold(cfg.Method, string(ctx.Method())) {
+			continue
+		}
+		if rule.path != nil && !rule.path.Match(ctx.Path()) {
+			continue
+		}
+		if cfg.Header != "" {
+			value := ctx.Request.Header.Peek(cfg.Header)
+			if value == nil || (rule.headerPattern != nil && !rule.headerPattern.Match(value)) {
+				continue
+			}
+		}
+		if rule.body != nil && !rule.body.Match(ctx.PostBody()) {
+			continue
+		}
+		if cfg.Probability > 0 && rand.Float64() >= cfg.Probability {
+			continue
+		}
+		cfg.Hits++
+		return proto.Clone(cfg).(*model.FaultRule)
+	}
+	return nil
+}
+
+// Middleware applies the rules to all requests except those whose path starts with one of the excluded prefixes
+func (f *FaultInjector) Middleware(h fasthttp.RequestHandler, exclude ...string) fasthttp.RequestHandler {
+	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
+		path := string(ctx.Path())
+		for _, prefix := range exclude {
+			if strings.HasPrefix(path, prefix) {
+				h(ctx)
+				return
+			}
+		}
+
+		rule := f.match(ctx)
+		if rule == nil {
+			h(ctx)
+			return
+		}
+		f.Log.Info("Injecting fault", "rule", rule.Id, "method", string(ctx.Method()), "path", path)
+
+		if rule.LatencyMs > 0 {
+			time.Sleep(time.Duration(rule.LatencyMs) * time.Millisecond)
+		}
+
+		switch {
+		case rule.DropConnection:
+			ctx.HijackSetNoResponse(true)
+			ctx.Hijack(dropConnection)
+
+		case rule.ErrorCode != 0 || rule.StatusCode != 0:
+			code, _ := model.LookupErrorCode(rule.ErrorCode)
+			if rule.ErrorCode == 0 {
+				code = errorCodeForStatus(int(rule.StatusCode))
+			}
+			statusCode := code.StatusCode
+			if rule.StatusCode != 0 {
+				statusCode = int(rule.StatusCode)
+			}
+			details := rule.ErrorDetails
+			if details == "" {
+				details = "Injected fault " + rule.Id
+			}
+			returnError(ctx, statusCode, code.New(details))
+
+		default:
+			h(ctx)
+		}
+	})
+}
+
+// unsafeConner is implemented by the connection which fasthttp passes to hijack handlers
+type unsafeConner interface {
+	UnsafeConn() net.Conn
+}
+
+// netConner is implemented by *tls.Conn since Go 1.18
+type netConner interface {
+	NetConn() net.Conn
+}
+
+// dropConnection resets the connection instead of closing it gracefully.
+// TLS connections are reset without a close_notify alert. With Go versions
+// before 1.18, they cannot be unwrapped and are closed gracefully.
+func dropConnection(c net.Conn) {
+	if hijacked, ok := c.(unsafeConner); ok {
+		c = hijacked.UnsafeConn()
+	}
+	if tlsConn, ok := c.(netConner); ok {
+		c = tlsConn.NetConn()
+	}
+	if tcp, ok := c.(*net.TCPConn); ok {
+		tcp.SetLinger(0)
+	}
+	c.Close()
+}
+
+// errorCodeForStatus returns the catalogue entry which is used if a rule only defines a status code
+func errorCodeForStatus(statusCode int) model.ErrorCode {
+	switch {
+	case statusCode == 401 || statusCode == 403:
+		return model.ErrAccessDenied
+	case statusCode == 404:
+		return model.ErrResourceNotFound
+	case statusCode == 429:
+		return model.ErrTooManyRequests
+	case statusCode == 503:
+		return model.ErrServiceNotReady
+	case statusCode >= 500:
+		return model.ErrInternal
+	default:
+		return model.ErrGenericUser
+	}
+}
+
+func compileOptional(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+// AddFaultRule godoc
+// @Summary Add a fault injection rule
+// @Description Inject errors, latency or dropped connections into the API requests which match the rule
+// @Tags mock
+// @Consume json
+// @Produce json
+// @Param body body model.FaultRule true "the fault rule"
+// @Success 200 {object} model.FaultRule
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/faults [post]
+// @Security ControlAuth
+func (a *API) AddFaultRule(ctx *fasthttp.RequestCtx) {
+	cfg := &model.FaultRule{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, cfg); err != nil {
+		logger.Warn("Unable to add fault rule", "error", err)
+		return
+	}
+
+	rule, err := a.Faults.Add(cfg)
+	if err != nil {
+		logger.Warn("Unable to add fault rule", "error", err)
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
+		return
+	}
+	a.Log.Info("Added fault rule", "rule", rule.Id)
+	returnJSON(ctx, 200, rule)
+}
+
+// ListFaultRules godoc
+// @Summary List all fault injection rules
+// @Tags mock
+// @Produce json
+// @Success 200 {object} model.FaultRules
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/faults [get]
+// @Security ControlAuth
+func (a *API) ListFaultRules(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Faults.List())
+}
+
+// DeleteFaultRule godoc
+// @Summary Delete a fault injection rule
+// @Tags mock
+// @Param id path string true "ID of the rule"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/faults/{id} [delete]
+// @Security ControlAuth
+func (a *API) DeleteFaultRule(ctx *fasthttp.RequestCtx) {
+	id := ctx.UserValue("id").(string)
+	if !a.Faults.Remove(id) {
+		returnErrorCode(ctx, model.ErrResourceNotFound, "Could not find fault rule with id "+id)
+		return
+	}
+	a.Log.Info("Deleted fault rule", "rule", id)
+}
+
+// ClearFaultRules godoc
+// @Summary Delete all fault injection rules
+// @Tags mock
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/faults [delete]
+// @Security ControlAuth
+func (a *API) ClearFaultRules(ctx *fasthttp.RequestCtx) {
+	a.Faults.Clear()
+	a.Log.Info("Deleted all fault rules")
+}
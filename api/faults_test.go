@@ -0,0 +1,105 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var _ = Describe("Faults API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	Context("Add a fault rule with a count limit", func() {
+		body := bytes.NewBufferString(`{"method": "POST", "path": "^/v1/contacts$", "errorCode": 1015, "count": 1}`)
+		resp, err := client.Do(NewControlRequest("POST", "/faults", body))
+		PanicIfNotNil(err)
+
+		rule := new(model.FaultRule)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, rule))
+
+		It("Should have status code 200", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(rule.Id).ToNot(BeEmpty())
+		})
+
+		sendContacts := func() *http.Response {
+			body := bytes.NewBufferString(`{"blocking": "wait", "contacts": ["+491701223123"]}`)
+			req, _ := http.NewRequest("POST", baseUrl+"/contacts", body)
+			req.Header.Set("Authorization", "Bearer "+authToken)
+			resp, err := client.Do(req)
+			PanicIfNotNil(err)
+			return resp
+		}
+
+		faulted := sendContacts()
+		passed := sendContacts()
+
+		It("Should inject the fault once", func() {
+			Expect(faulted.StatusCode).To(Equal(429))
+
+			errResp := new(model.ErrorResponse)
+			PanicIfNotNil(unmarsheler.Unmarshal(faulted.Body, errResp))
+			Expect(errResp.Errors).To(HaveLen(1))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrTooManyRequests.Code))
+
+			Expect(passed.StatusCode).To(Equal(200))
+		})
+	})
+
+	Context("Add a fault rule with an unknown error code", func() {
+		body := bytes.NewBufferString(`{"errorCode": 42}`)
+		resp, err := client.Do(NewControlRequest("POST", "/faults", body))
+		PanicIfNotNil(err)
+
+		It("Should have status code 400", func() {
+			Expect(resp.StatusCode).To(Equal(400))
+		})
+	})
+
+	Context("Add a fault rule with an invalid status code", func() {
+		body := bytes.NewBufferString(`{"statusCode": 42}`)
+		resp, err := client.Do(NewControlRequest("POST", "/faults", body))
+		PanicIfNotNil(err)
+
+		It("Should have status code 400", func() {
+			Expect(resp.StatusCode).To(Equal(400))
+		})
+	})
+	Context("Drop the connection", func() {
+		faults := w_api.NewFaultInjector()
+		_, err := faults.Add(&model.FaultRule{DropConnection: true})
+		PanicIfNotNil(err)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		PanicIfNotNil(err)
+		server := &fasthttp.Server{Handler: faults.Middleware(func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(200)
+		})}
+		go server.Serve(ln)
+		defer server.Shutdown()
+
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		PanicIfNotNil(err)
+		defer conn.Close()
+		_, err = conn.Write([]byte("GET /v1/health HTTP/1.1\r\nHost: localhost\r\n\r\n"))
+		PanicIfNotNil(err)
+		PanicIfNotNil(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		_, readErr := conn.Read(make([]byte, 1))
+
+		It("Should reset the connection", func() {
+			Expect(errors.Is(readErr, syscall.ECONNRESET)).To(BeTrue(), "read error: %v", readErr)
+		})
+	})
+})
@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
 	"github.com/valyala/fasthttp"
 )
 
@@ -25,6 +27,30 @@ func (a *API) SendMessages(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	if !a.Registration.Registered() {
+		logger.Warn("Unable to send message", "error", "account is not registered")
+		returnErrorCode(ctx, model.ErrServiceNotReady, "The account is not registered")
+		return
+	}
+
+	if !a.Gateway.Connected() {
+		logger.Warn("Unable to send message", "error", "gateway is disconnected")
+		returnErrorCode(ctx, model.ErrServiceNotReady, "The gateway is not connected to the WhatsApp servers")
+		return
+	}
+
+	if !a.Webhook.Accepting() {
+		logger.Warn("Unable to send message", "error", webhook.ErrQueueFull)
+		returnQueueFull(ctx)
+		return
+	}
+
+	if recipient := cleanUp.ReplaceAllString(msg.To, ""); !a.Tiers.Allow(recipient) {
+		logger.Warn("Unable to send message", "error", "messaging tier limit reached", "tier", a.Tiers.Tier().String())
+		returnErrorCode(ctx, model.ErrSpamRateLimit, "The limit of unique recipients in 24 hours of the messaging tier is reached")
+		return
+	}
+
 	// return
 	id := uuid.New().String()
 	logger.Info("Generated message ", "msg_id", id)
@@ -35,10 +61,36 @@ func (a *API) SendMessages(ctx *fasthttp.RequestCtx) {
 	resp.Messages = append(resp.Messages, &model.Id{Id: id})
 	returnJSON(ctx, 200, resp)
 
-	stati := a.Webhook.Generators.GenerateSatiForMessage(msg)
+	settings := a.Config.Current().ApplicationSettings
+	failed := a.Quality.Record()
+	monitoring.Messages.WithLabelValues(a.Webhook.Tenant, "outbound", msg.Type.String()).Inc()
+	var stati []*model.Status
+	if failed {
+		logger.Info("Simulated failed delivery", "msg_id", id)
+		stati = a.Webhook.CurrentGenerators().GenerateFailedSatiForMessage(msg, settings.SentStatus)
+	} else {
+		stati = a.Webhook.CurrentGenerators().GenerateSatiForMessage(msg, settings.SentStatus)
+	}
+	if !settings.PassThrough {
+		a.Messages.Add(msg, stati...)
+	}
 	a.Webhook.AddStati(stati...)
 }
 
+// receiveMessages opens the customer service window for the senders of the generated inbound messages
+// and keeps them if pass_through is disabled
+func (a *API) receiveMessages(messages []*model.Message) {
+	a.Tiers.Inbound(messages...)
+	if a.Config.Current().ApplicationSettings.PassThrough {
+		return
+	}
+	for _, msg := range messages {
+		if msg != nil {
+			a.Messages.Add(msg)
+		}
+	}
+}
+
 func (a *API) Contacts(ctx *fasthttp.RequestCtx) {
 	msg := new(model.ContactRequest)
 	msg.Reset()
@@ -101,14 +153,29 @@ func (a *API) GenerateWebhookRequests(ctx *fasthttp.RequestCtx) {
 				case <-a.cancel:
 					return
 
+				case <-a.done:
+					return
+
 				case <-time.After(dur * time.Second):
-					go a.Webhook.GenerateWebhookRequests(n, allowedTypes...)
+					go func() {
+						messages, err := a.Webhook.GenerateWebhookRequests(n, allowedTypes...)
+						if err != nil {
+							a.Log.Warn("Unable to generate webhook requests", "error", err)
+						}
+						a.receiveMessages(messages)
+					}()
 				}
 			}
 		}()
 
 	} else {
-		messages := a.Webhook.GenerateWebhookRequests(n, allowedTypes...)
+		messages, err := a.Webhook.GenerateWebhookRequests(n, allowedTypes...)
+		a.receiveMessages(messages)
+		if err != nil {
+			a.LoggerFromCtx(ctx).Warn("Unable to generate webhook requests", "error", err)
+			returnQueueFull(ctx)
+			return
+		}
 
 		resp := AcquireIdResponse()
 		resp.Reset()
@@ -128,15 +195,9 @@ func (a *API) CancelGenerateWebhookRquests(ctx *fasthttp.RequestCtx) {
 
 func (a *API) PanicHandler(ctx *fasthttp.RequestCtx, in interface{}) {
 	a.Log.Crit("Panic handler catched error", "error", in)
-	returnError(ctx, 500, model.Error{
-		Code:    500,
-		Details: "An unexpected error occured",
-		Title:   "Unexpected Error",
-		Href:    "",
-	})
+	returnErrorCode(ctx, model.ErrInternal, "An unexpected error occured")
 }
 
-func HealthCheck(ctx *fasthttp.RequestCtx) {
-	ctx.Write([]byte("OK"))
-	ctx.SetStatusCode(200)
+func returnQueueFull(ctx *fasthttp.RequestCtx) {
+	returnErrorCode(ctx, model.ErrSystemOverloaded, "The webhook queue is full. Try again later")
 }
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	GatewayConnected     = "connected"
+	GatewayDisconnected  = "disconnected"
+	GatewayUninitialized = "uninitialized"
+	GatewayUnregistered  = "unregistered"
+
+	RolePrimaryMaster = "primary_master"
+	RoleCoreapp       = "coreapp"
+)
+
+// Gateway simulates the connectivity of the nodes to the WhatsApp servers.
+// Without nodes, a single instance setup is simulated.
+type Gateway struct {
+	status string
+	nodes  map[string]string
+	mux    sync.RWMutex
+}
+
+func NewGateway() *Gateway {
+	return &Gateway{
+		status: GatewayConnected,
+		nodes:  map[string]string{},
+	}
+}
+
+// Set replaces the connectivity of the gateway
+func (g *Gateway) Set(cfg *model.GatewayConnectivity) error {
+	nodes := map[string]string{}
+	for node, status := range cfg.Nodes {
+		if !validGatewayStatus(status) {
+			return fmt.Errorf("invalid gateway_status %q of node %s", status, node)
+		}
+		nodes[node] = status
+	}
+	status := cfg.GatewayStatus
+	if status == "" {
+		status = GatewayConnected
+	}
+	if !validGatewayStatus(status) {
+		return fmt.Errorf("invalid gateway_status %q", status)
+	}
+
+	g.mux.Lock()
+	g.status = status
+	g.nodes = nodes
+	g.mux.Unlock()
+	return nil
+}
+
+// Connected returns whether at least one node is connected
+func (g *Gateway) Connected() bool {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	if len(g.nodes) == 0 {
+		return g.status == GatewayConnected
+	}
+	for _, status := range g.nodes {
+		if status == GatewayConnected {
+			return true
+		}
+	}
+	return false
+}
+
+// Health returns the health of the gateway in the shape of the WhatsApp Business API.
+// An unregistered account is reported as unregistered unless the node is uninitialized.
+func (g *Gateway) Health(registered bool) map[string]interface{} {
+	status := func(s string) string {
+		if !registered && s != GatewayUninitialized {
+			return GatewayUnregistered
+		}
+		return s
+	}
+
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	if len(g.nodes) == 0 {
+		return map[string]interface{}{
+			"gateway_status": status(g.status),
+		}
+	}
+
+	names := make([]string, 0, len(g.nodes))
+	for node := range g.nodes {
+		names = append(names, node)
+	}
+	sort.Strings(names)
+
+	health := make(map[string]interface{}, len(names))
+	for i, node := range names {
+		role := RoleCoreapp
+		if i == 0 {
+			role = RolePrimaryMaster
+		}
+		health[node] = map[string]string{
+			"gateway_status": status(g.nodes[node]),
+			"role":           role,
+		}
+	}
+	return health
+}
+
+func validGatewayStatus(status string) bool {
+	return status == GatewayConnected || status == GatewayDisconnected || status == GatewayUninitialized
+}
+
+// HealthCheck godoc
+// @Summary Get the health of the gateway
+// @Description The gateway_status is connected, disconnected, uninitialized or unregistered. Multiconnect setups return one entry per node
+// @Tags health
+// @Produce json
+// @Success 200 {object} object
+// @Failure default {object} model.ErrorResponse
+// @Router /health [get]
+// @Security BearerAuth
+func (a *API) HealthCheck(ctx *fasthttp.RequestCtx) {
+	body, err := json.Marshal(map[string]interface{}{
+		"health": a.Gateway.Health(a.Registration.Registered()),
+		"meta": map[string]string{
+			"version":    Version,
+			"api_status": ApiStatus.String(),
+		},
+	})
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.SetStatusCode(200)
+	ctx.Write(body)
+}
+
+// SetGatewayConnectivity godoc
+// @Summary Set the connectivity of the gateway
+// @Description Simulate a disconnected or uninitialized gateway. If the gateway is disconnected, messages cannot be sent
+// @Tags mock
+// @Consume json
+// @Produce json
+// @Param body body model.GatewayConnectivity true "the connectivity of the gateway or its nodes"
+// @Success 200 {object} object
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/health/gateway [post]
+// @Security ControlAuth
+func (a *API) SetGatewayConnectivity(ctx *fasthttp.RequestCtx) {
+	cfg := &model.GatewayConnectivity{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, cfg); err != nil {
+		logger.Warn("Unable to set gateway connectivity", "error", err)
+		return
+	}
+
+	if err := a.Gateway.Set(cfg); err != nil {
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
+		return
+	}
+	a.Log.Info("Updated gateway connectivity", "connected", a.Gateway.Connected())
+	a.HealthCheck(ctx)
+}
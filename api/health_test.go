@@ -0,0 +1,54 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Health API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	getHealth := func() map[string]map[string]interface{} {
+		req, _ := http.NewRequest("GET", baseUrl+"/health", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		health := map[string]map[string]interface{}{}
+		PanicIfNotNil(json.NewDecoder(resp.Body).Decode(&health))
+		return health
+	}
+
+	Context("Get the health of a registered instance", func() {
+		health := getHealth()
+
+		It("Should return the gateway status", func() {
+			Expect(health["health"]["gateway_status"]).To(Equal("connected"))
+		})
+	})
+
+	Context("Disconnect the gateway", func() {
+		resp, err := client.Do(NewControlRequest("POST", "/health/gateway", bytes.NewBufferString(`{"gateway_status": "disconnected"}`)))
+		PanicIfNotNil(err)
+
+		req, _ := http.NewRequest("POST", baseUrl+"/messages", bytes.NewBufferString(`{"to": "491701223123", "type": "text", "text": {"body": "Hello"}}`))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		msgResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		api.Gateway.Set(&model.GatewayConnectivity{GatewayStatus: "connected"})
+
+		It("Should reject messages", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(msgResp.StatusCode).To(Equal(503))
+		})
+	})
+})
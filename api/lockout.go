@@ -0,0 +1,206 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// LoginMaxUserFailures is the number of failed logins after which a user is locked. 0 disables the lock
+	LoginMaxUserFailures = 5
+	// LoginMaxIPFailures is the number of failed logins after which a source IP is locked. 0 disables the lock
+	LoginMaxIPFailures = 20
+	// LoginLockoutDuration is the duration of a lock. Failed logins which are older are forgotten
+	LoginLockoutDuration = 15 * time.Minute
+)
+
+// LoginGuard locks users and source IPs after too many failed logins
+type LoginGuard struct {
+	MaxUserFailures int
+	MaxIPFailures   int
+	Duration        time.Duration
+	users           map[string]*loginFailures
+	ips             map[string]*loginFailures
+	mux             sync.Mutex
+}
+
+type loginFailures struct {
+	count       int
+	since       time.Time
+	lockedUntil time.Time
+}
+
+func (f *loginFailures) locked(now time.Time) bool {
+	return now.Before(f.lockedUntil)
+}
+
+func (f *loginFailures) expired(now time.Time, window time.Duration) bool {
+	return !f.locked(now) && now.Sub(f.since) >= window
+}
+
+func NewLoginGuard(maxUserFailures, maxIPFailures int, duration time.Duration) *LoginGuard {
+	return &LoginGuard{
+		MaxUserFailures: maxUserFailures,
+		MaxIPFailures:   maxIPFailures,
+		Duration:        duration,
+		users:           map[string]*loginFailures{},
+		ips:             map[string]*loginFailures{},
+	}
+}
+
+// Check returns the remaining durations of the locks of the user and the source IP
+func (g *LoginGuard) Check(user, ip string) (userRemaining, ipRemaining time.Duration) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	now := time.Now()
+	remaining := func(f *loginFailures) time.Duration {
+		if f == nil || !f.locked(now) {
+			return 0
+		}
+		return f.lockedUntil.Sub(now)
+	}
+	return remaining(g.users[user]), remaining(g.ips[ip])
+}
+
+// Fail records a failed login of the user from the source IP
+func (g *LoginGuard) Fail(user, ip string) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	now := time.Now()
+	g.prune(now)
+	g.fail(g.users, user, g.MaxUserFailures, now)
+	g.fail(g.ips, ip, g.MaxIPFailures, now)
+}
+
+func (g *LoginGuard) fail(failures map[string]*loginFailures, key string, max int, now time.Time) {
+	f, ok := failures[key]
+	if !ok || f.expired(now, g.Duration) {
+		f = &loginFailures{since: now}
+		failures[key] = f
+	}
+	f.count++
+	if max > 0 && f.count >= max && !f.locked(now) {
+		f.lockedUntil = now.Add(g.Duration)
+	}
+}
+
+// prune removes the failures which are forgotten. The caller must hold the lock.
+func (g *LoginGuard) prune(now time.Time) {
+	for _, failures := range []map[string]*loginFailures{g.users, g.ips} {
+		for key, f := range failures {
+			if f.expired(now, g.Duration) {
+				delete(failures, key)
+			}
+		}
+	}
+}
+
+// Succeed resets the failed logins of the user.
+// The failures of the source IP are kept as it may guess the passwords of several users.
+func (g *LoginGuard) Succeed(user string) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	delete(g.users, user)
+}
+
+// Clear removes the failures and locks of the user and the source IP.
+// If both are empty, all locks are removed. It returns the number of removed entries.
+func (g *LoginGuard) Clear(user, ip string) int {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if user == "" && ip == "" {
+		cleared := len(g.users) + len(g.ips)
+		g.users = map[string]*loginFailures{}
+		g.ips = map[string]*loginFailures{}
+		return cleared
+	}
+	cleared := 0
+	if _, ok := g.users[user]; ok {
+		delete(g.users, user)
+		cleared++
+	}
+	if _, ok := g.ips[ip]; ok {
+		delete(g.ips, ip)
+		cleared++
+	}
+	return cleared
+}
+
+// Lockouts returns the locked users and source IPs
+func (g *LoginGuard) Lockouts() *model.LockoutList {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	now := time.Now()
+	list := &model.LockoutList{}
+	for user, f := range g.users {
+		if f.locked(now) {
+			list.Lockouts = append(list.Lockouts, &model.Lockout{User: user, Failures: int32(f.count), LockedUntil: f.lockedUntil.Unix()})
+		}
+	}
+	for ip, f := range g.ips {
+		if f.locked(now) {
+			list.Lockouts = append(list.Lockouts, &model.Lockout{Ip: ip, Failures: int32(f.count), LockedUntil: f.lockedUntil.Unix()})
+		}
+	}
+	sort.Slice(list.Lockouts, func(i, j int) bool {
+		if list.Lockouts[i].User != list.Lockouts[j].User {
+			return list.Lockouts[i].User < list.Lockouts[j].User
+		}
+		return list.Lockouts[i].Ip < list.Lockouts[j].Ip
+	})
+	return list
+}
+
+// returnLockedOut rejects a login of a locked user or source IP.
+// A locked user is rejected with error 1031, a locked source IP is throttled with error 1015.
+func returnLockedOut(ctx *fasthttp.RequestCtx, userRemaining, ipRemaining time.Duration) {
+	remaining := userRemaining
+	if ipRemaining > remaining {
+		remaining = ipRemaining
+	}
+	seconds := int(remaining.Round(time.Second) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	ctx.Response.Header.Set("Retry-After", strconv.Itoa(seconds))
+	if userRemaining > 0 {
+		returnErrorCode(ctx, model.ErrAccountLocked, fmt.Sprintf("The account is locked after too many failed login attempts. Try again in %d seconds", seconds))
+		return
+	}
+	returnErrorCode(ctx, model.ErrTooManyRequests, fmt.Sprintf("Too many failed login attempts. Try again in %d seconds", seconds))
+}
+
+// ListLockouts godoc
+// @Summary List the users and source IPs which are locked after too many failed logins
+// @Tags admin
+// @Produce json
+// @Success 200 {object} model.LockoutList
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/lockouts [get]
+// @Security ControlAuth
+func (a *API) ListLockouts(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Logins.Lockouts())
+}
+
+// ClearLockouts godoc
+// @Summary Unlock users and source IPs
+// @Description Removes the failed logins of the user and/or the source IP. Without both, all locks are removed
+// @Tags admin
+// @Param user query string false "name of the user"
+// @Param ip query string false "source IP"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/lockouts [delete]
+// @Security ControlAuth
+func (a *API) ClearLockouts(ctx *fasthttp.RequestCtx) {
+	user := string(ctx.QueryArgs().Peek("user"))
+	ip := string(ctx.QueryArgs().Peek("ip"))
+	cleared := a.Logins.Clear(user, ip)
+	a.LoggerFromCtx(ctx).Info("Cleared login lockouts", "user", user, "ip", ip, "cleared", cleared)
+}
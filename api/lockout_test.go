@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"github.com/valyala/fasthttp"
+)
+
+var _ = Describe("Login Lockout", func() {
+	defer GinkgoRecover()
+
+	cfg := w_api.NewConfig()
+	cfg.Users["admin"] = "Admin123!"
+	cfg.Contacts = []*model.InternalContact{{Id: "491701223123", Name: "Peter P."}}
+	wh := webhook.NewWebhook("https://localhost:9000/webhook", cfg.Version, generators)
+	lockoutAPI := w_api.NewAPI(apiPrefix, staticAPIToken, uint(20), cfg, wh)
+	lockoutAPI.Logins = w_api.NewLoginGuard(2, 0, time.Minute)
+
+	login := func(password string) *fasthttp.RequestCtx {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:"+password)))
+		lockoutAPI.Login(ctx)
+		return ctx
+	}
+
+	errorCode := func(ctx *fasthttp.RequestCtx) int32 {
+		errResp := &model.ErrorResponse{}
+		PanicIfNotNil(unmarsheler.Unmarshal(bytes.NewReader(ctx.Response.Body()), errResp))
+		return errResp.Errors[0].Code
+	}
+
+	Context("Login after too many failed attempts", func() {
+		first := login("wrong").Response.StatusCode()
+		second := login("wrong").Response.StatusCode()
+		locked := login("Admin123!")
+		lockedCode := errorCode(locked)
+		lockouts := lockoutAPI.Logins.Lockouts()
+
+		It("Should reject the correct password while the user is locked", func() {
+			Expect(first).To(Equal(401))
+			Expect(second).To(Equal(401))
+			Expect(locked.Response.StatusCode()).To(Equal(403))
+			Expect(lockedCode).To(Equal(model.ErrAccountLocked.Code))
+			Expect(strconv.Atoi(string(locked.Response.Header.Peek("Retry-After")))).To(BeNumerically("~", 60, 5))
+		})
+
+		It("Should list the locked user", func() {
+			Expect(lockouts.Lockouts).To(HaveLen(1))
+			Expect(lockouts.Lockouts[0].User).To(Equal("admin"))
+		})
+	})
+
+	Context("Login after the lockout has been cleared", func() {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.QueryArgs().Set("user", "admin")
+		lockoutAPI.ClearLockouts(ctx)
+		status := login("Admin123!").Response.StatusCode()
+
+		It("Should succeed", func() {
+			Expect(status).To(Equal(200))
+		})
+	})
+
+	Context("Login from a locked source IP", func() {
+		lockoutAPI.Logins = w_api.NewLoginGuard(0, 1, time.Minute)
+		first := login("wrong").Response.StatusCode()
+		throttled := login("Admin123!")
+		throttledCode := errorCode(throttled)
+
+		It("Should throttle the source IP", func() {
+			Expect(first).To(Equal(401))
+			Expect(throttled.Response.StatusCode()).To(Equal(429))
+			Expect(throttledCode).To(Equal(model.ErrTooManyRequests.Code))
+			Expect(throttled.Response.Header.Peek("Retry-After")).ToNot(BeEmpty())
+		})
+	})
+})
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
@@ -19,10 +22,26 @@ import (
 // @Failure default {object} model.ErrorResponse
 // @Router /media [post]
 // @Security BearerAuth
-func SaveMedia(ctx *fasthttp.RequestCtx) {
+func (a *API) SaveMedia(ctx *fasthttp.RequestCtx) {
 	fileID := uuid.New().String()
+	uploadDir := a.Config.Current().UploadDir
 
-	if !savePostBody(ctx, fileID) {
+	if !savePostBody(ctx, filepath.Join(uploadDir, fileID)) {
+		return
+	}
+
+	sum := sha256.Sum256(ctx.PostBody())
+	metadata := &model.MediaMetadata{
+		Id:       fileID,
+		MimeType: string(ctx.Request.Header.ContentType()),
+		FileSize: int64(len(ctx.PostBody())),
+		Sha256:   hex.EncodeToString(sum[:]),
+		Created:  time.Now().Unix(),
+	}
+	if err := a.Store.Put(MediaStatePrefix+fileID, metadata); err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to persist media metadata", "error", err)
+		_ = os.Remove(filepath.Join(uploadDir, fileID))
+		returnErrorCode(ctx, model.ErrInternal, "Unable to persist the state")
 		return
 	}
 
@@ -48,7 +67,7 @@ func SaveMedia(ctx *fasthttp.RequestCtx) {
 func (a *API) RetrieveMedia(ctx *fasthttp.RequestCtx) {
 	id := ctx.UserValue("id").(string)
 	filename := filepath.Base(id)
-	respondWithFile(ctx, 200, filepath.Join(a.Config.UploadDir, filename))
+	respondWithFile(ctx, 200, filepath.Join(a.Config.Current().UploadDir, filename))
 }
 
 // DeleteMedia godoc
@@ -63,22 +82,20 @@ func (a *API) RetrieveMedia(ctx *fasthttp.RequestCtx) {
 func (a *API) DeleteMedia(ctx *fasthttp.RequestCtx) {
 	id := ctx.UserValue("id").(string)
 	filename := filepath.Base(id)
-	err := os.Remove(a.Config.UploadDir + filename)
+	err := os.Remove(filepath.Join(a.Config.Current().UploadDir, filename))
 	if err == nil {
+		if err = a.Store.Delete(MediaStatePrefix + filename); err != nil {
+			a.LoggerFromCtx(ctx).Error("Unable to delete media metadata", "error", err)
+		}
 		ctx.SetStatusCode(200)
 		return
 
 	} else if os.IsNotExist(err) {
-		ctx.SetStatusCode(404)
+		returnErrorCode(ctx, model.ErrResourceNotFound, "The requested file does not exist")
 		return
 
 	} else {
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Details: err.Error(),
-			Title:   "Server Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
 		return
 	}
 }
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// MaxStoredMessages is the maximum number of messages that are kept if pass_through is disabled.
+	// If it is exceeded, the oldest messages are removed.
+	MaxStoredMessages = 10000
+)
+
+// MessageStore keeps the inbound and outbound messages together with their stati
+// if pass_through is disabled in the application settings
+type MessageStore struct {
+	messages map[string]*model.WebhookRequest
+	order    []string
+	mux      sync.RWMutex
+}
+
+func NewMessageStore() *MessageStore {
+	return &MessageStore{
+		messages: map[string]*model.WebhookRequest{},
+		order:    []string{},
+	}
+}
+
+// Add stores a copy of the message and its stati
+func (s *MessageStore) Add(msg *model.Message, stati ...*model.Status) {
+	entry := &model.WebhookRequest{
+		Messages: []*model.Message{proto.Clone(msg).(*model.Message)},
+	}
+	for _, status := range stati {
+		entry.Statuses = append(entry.Statuses, proto.Clone(status).(*model.Status))
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, exists := s.messages[msg.Id]; !exists {
+		s.order = append(s.order, msg.Id)
+	}
+	s.messages[msg.Id] = entry
+
+	for len(s.order) > MaxStoredMessages {
+		delete(s.messages, s.order[0])
+		s.order = s.order[1:]
+	}
+}
+
+// Get returns the stored message and its stati
+func (s *MessageStore) Get(id string) (*model.WebhookRequest, bool) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	entry, ok := s.messages[id]
+	return entry, ok
+}
+
+func (s *MessageStore) Len() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return len(s.messages)
+}
+
+// RetrieveMessage godoc
+// @Summary Retrieve a message
+// @Description Retrieve an inbound or outbound message and its stati. Messages are only kept if pass_through is disabled
+// @Tags messages
+// @Produce json
+// @Param id path string true "ID of the message"
+// @Success 200 {object} model.WebhookRequest
+// @Failure default {object} model.ErrorResponse
+// @Router /messages/{id} [get]
+// @Security BearerAuth
+func (a *API) RetrieveMessage(ctx *fasthttp.RequestCtx) {
+	id := ctx.UserValue("id").(string)
+	entry, ok := a.Messages.Get(id)
+	if !ok {
+		returnErrorCode(ctx, model.ErrResourceNotFound, "Could not find message with id "+id)
+		return
+	}
+	returnJSON(ctx, 200, entry)
+}
@@ -0,0 +1,72 @@
+package api_test
+
+import (
+	"bytes"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Messages API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	Context("Disable sent_status and pass_through", func() {
+		body := bytes.NewBufferString(`{"sent_status": false, "pass_through": false}`)
+		req, _ := http.NewRequest("PATCH", baseUrl+"/settings/application", body)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		It("Should have status code 200", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+		})
+
+		It("Should have updated the settings", func() {
+			Expect(api.Config.Current().ApplicationSettings.SentStatus).To(BeFalse())
+			Expect(api.Config.Current().ApplicationSettings.PassThrough).To(BeFalse())
+		})
+	})
+
+	Context("Send and retrieve a message", func() {
+		body := bytes.NewBufferString(`{"to": "491701223123", "type": "text", "text": {"body": "Hello"}}`)
+		req, _ := http.NewRequest("POST", baseUrl+"/messages", body)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		idResp := new(model.IdResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, idResp))
+
+		It("Should have status code 200", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(idResp.Messages).To(HaveLen(1))
+		})
+
+		req, _ = http.NewRequest("GET", baseUrl+"/messages/"+idResp.Messages[0].Id, nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err = client.Do(req)
+		PanicIfNotNil(err)
+
+		It("Should have kept the message without sent status", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+
+			stored := new(model.WebhookRequest)
+			PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, stored))
+
+			Expect(stored.Messages).To(HaveLen(1))
+			Expect(stored.Messages[0].Text.Body).To(Equal("Hello"))
+			Expect(stored.Statuses).To(HaveLen(2))
+			for _, s := range stored.Statuses {
+				Expect(s.Status).ToNot(Equal(model.Status_sent))
+			}
+		})
+	})
+})
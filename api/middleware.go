@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
 	"github.com/ron96G/whatsapp-bizapi-mock/util"
 	"github.com/uber/jaeger-client-go/config"
 	"github.com/valyala/fasthttp"
@@ -12,85 +13,69 @@ import (
 
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
-
-	log "github.com/ron96G/go-common-utils/log"
 )
 
 const (
 	serviceName     = "wabiz-mockserver"
 	componentName   = "fasthttp"
 	requestIDHeader = "X-Request-ID"
+	claimsUserValue = "claims"
 )
 
-func (a *API) Authorize(h fasthttp.RequestHandler) fasthttp.RequestHandler {
-	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
-		logger := a.LoggerFromCtx(ctx)
-		token, err := parseTokenWithClaims(ctx)
-		if err != nil {
-			logger.Warn("Failed to authorize user", "reason", "failed to parse token", "error", err)
-
-		} else if token.Valid && a.Tokens.Contains(token.Raw) {
-			logger.Info("Successfully authorized user",
-				"subject", token.Claims.(*CustomClaims).Subject,
-			)
-			h(ctx)
-			return
-
-		} else {
-			logger.Warn("Failed to authorize user", "reason", "token is invalid")
-		}
+// claimsFromCtx returns the claims of the authorized token of the request
+func claimsFromCtx(ctx *fasthttp.RequestCtx) *CustomClaims {
+	claims, _ := ctx.UserValue(claimsUserValue).(*CustomClaims)
+	if claims == nil {
+		return &CustomClaims{}
+	}
+	return claims
+}
 
-		ctx.SetStatusCode(401)
+// Authorize checks the bearer token and whether its role may access the matched route
+func (a *API) Authorize(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return a.authorize(h, func(ctx *fasthttp.RequestCtx, claims *CustomClaims) bool {
+		return a.Permissions.Allowed(string(ctx.Method()), a.route(ctx), claims.Role)
 	})
 }
 
+// AuthorizeWithRoles checks the bearer token and whether its role is one of the roles
 func (a *API) AuthorizeWithRoles(h fasthttp.RequestHandler, roles []string) fasthttp.RequestHandler {
+	return a.authorize(h, func(ctx *fasthttp.RequestCtx, claims *CustomClaims) bool {
+		return contains(roles, claims.Role)
+	})
+}
+
+func (a *API) authorize(h fasthttp.RequestHandler, allowed func(ctx *fasthttp.RequestCtx, claims *CustomClaims) bool) fasthttp.RequestHandler {
 	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
 		logger := a.LoggerFromCtx(ctx)
-		token, err := parseTokenWithClaims(ctx)
-		if err != nil {
-			logger.Warn("Failed to authorize user", "reason", "failed to parse token", "error", err)
+		token, ok := extractAuthToken(ctx, "Bearer ")
+		if !ok {
+			logger.Warn("Failed to authorize user", "reason", "unable to find bearer token in request")
 
-		} else if claims, ok := token.Claims.(*CustomClaims); ok &&
-			token.Valid &&
-			a.Tokens.Contains(token.Raw) {
+		} else if claims, err := a.Tokens.Validate(token); err != nil {
+			logger.Warn("Failed to authorize user", "reason", "token is invalid", "error", err)
 
-			if contains(roles, claims.Role) {
+		} else {
+			if allowed(ctx, claims) {
 				logger.Info("Successfully authorized user",
 					"subject", claims.Subject,
 					"role", claims.Role,
 				)
+				ctx.SetUserValue(claimsUserValue, claims)
 				h(ctx)
 				return
-
-			} else {
-				logger.Warn("Failed to authorize user",
-					"reason", "access denied due to role",
-					"subject", claims.Subject,
-					"role", claims.Role,
-				)
 			}
 
-		} else {
-			a.Log.Warn("Failed to authorize user", "reason", "token is invalid")
-		}
-
-		ctx.SetStatusCode(401)
-	})
-}
-
-func AuthorizeStaticToken(h fasthttp.RequestHandler, staticToken string) fasthttp.RequestHandler {
-	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
-		logger := log.FromContext(ctx)
-		token, _ := extractAuthToken(ctx, "Apikey")
-		if staticToken != "" && token != staticToken {
-			logger.Warn("Failed to authorize user", "reason", "invalid apikey")
-			ctx.SetStatusCode(401)
+			logger.Warn("Failed to authorize user",
+				"reason", "access denied due to role",
+				"subject", claims.Subject,
+				"role", claims.Role,
+			)
+			returnError(ctx, 403, model.ErrAccessDenied.Newf("The role %s may not access %s %s", claims.Role, ctx.Method(), ctx.Path()))
 			return
 		}
 
-		logger.Info("Successfully authorized user with apikey")
-		h(ctx)
+		returnError(ctx, 401, model.ErrAccessDenied.New("Invalid credentials"))
 	})
 }
 
@@ -98,7 +83,7 @@ func Limiter(h fasthttp.RequestHandler, concurrencyLimit uint) fasthttp.RequestH
 	limiter := rate.NewLimiter(rate.Limit(concurrencyLimit), int(concurrencyLimit))
 	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
 		if !limiter.Allow() {
-			ctx.SetStatusCode(429)
+			returnErrorCode(ctx, model.ErrTooManyRequests, "Too many requests. Try again later")
 			return
 		}
 		h(ctx)
@@ -152,7 +137,7 @@ func Tracer(h fasthttp.RequestHandler) fasthttp.RequestHandler {
 
 	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
 
-		req := ctx.Request
+		req := &ctx.Request
 		method := string(ctx.Method())
 		url := string(ctx.Path())
 		opname := "HTTP " + method + " URL: " + url
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/fasthttp/router"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	RoleAdmin = model.User_ADMIN.String()
+	RoleUser  = model.User_USER.String()
+
+	// DefaultPermissions follows the split of the WhatsApp Business API: users may send messages,
+	// check contacts, manage media, read the profile and check the health. Everything else is reserved for admins.
+	// The keys are the method and the route without the API prefix.
+	DefaultPermissions = map[string][]string{
+		"POST /messages":                 {RoleAdmin, RoleUser},
+		"GET /messages/{id}":             {RoleAdmin, RoleUser},
+		"POST /contacts":                 {RoleAdmin, RoleUser},
+		"POST /media":                    {RoleAdmin, RoleUser},
+		"GET /media/{id}":                {RoleAdmin, RoleUser},
+		"DELETE /media/{id}":             {RoleAdmin, RoleUser},
+		"POST /users/logout":             {RoleAdmin, RoleUser},
+		"GET /users/{name}":              {RoleAdmin, RoleUser},
+		"PUT /users/{name}":              {RoleAdmin, RoleUser},
+		"GET /settings/profile/about":    {RoleAdmin, RoleUser},
+		"GET /settings/profile/photo":    {RoleAdmin, RoleUser},
+		"GET /settings/business/profile": {RoleAdmin, RoleUser},
+		"GET /health":                    {RoleAdmin, RoleUser},
+	}
+)
+
+// Permissions is the matrix of the roles which may access the routes of the API.
+// Routes which are not contained are only accessible by admins.
+type Permissions struct {
+	roles map[string][]string
+	mux   sync.RWMutex
+}
+
+func NewPermissions(matrix map[string][]string) *Permissions {
+	p := &Permissions{roles: map[string][]string{}}
+	for route, roles := range matrix {
+		p.roles[route] = append([]string(nil), roles...)
+	}
+	return p
+}
+
+// Set replaces the roles which may access the route
+func (p *Permissions) Set(method, path string, roles ...string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.roles[method+" "+path] = roles
+}
+
+// Allowed returns whether the role may access the route
+func (p *Permissions) Allowed(method, path, role string) bool {
+	p.mux.RLock()
+	roles, ok := p.roles[method+" "+path]
+	p.mux.RUnlock()
+	if !ok {
+		return role == RoleAdmin
+	}
+	return contains(roles, role)
+}
+
+// route returns the matched route of the request without the API prefix
+func (a *API) route(ctx *fasthttp.RequestCtx) string {
+	path, _ := ctx.UserValue(router.MatchedRoutePathParam).(string)
+	return strings.TrimPrefix(path, a.apiPrefix)
+}
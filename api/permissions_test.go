@@ -0,0 +1,55 @@
+package api_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Permissions", func() {
+	defer GinkgoRecover()
+
+	userToken, err := api.GenerateToken("operator", w_api.RoleUser)
+	PanicIfNotNil(err)
+
+	get := func(path string) *http.Response {
+		req, _ := http.NewRequest("GET", baseUrl+path, nil)
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+		return resp
+	}
+
+	Context("Access an admin route as user", func() {
+		resp := get("/settings/application")
+		errResp := new(model.ErrorResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+
+		It("Should be forbidden", func() {
+			Expect(resp.StatusCode).To(Equal(403))
+			Expect(errResp.Errors).To(HaveLen(1))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrAccessDenied.Code))
+			Expect(errResp.Errors[0].Details).To(Equal("The role USER may not access GET /v1/settings/application"))
+		})
+	})
+
+	Context("Access a route which is granted to users", func() {
+		api.Permissions.Set("GET", "/settings/application", w_api.RoleAdmin, w_api.RoleUser)
+		resp := get("/settings/application")
+		api.Permissions.Set("GET", "/settings/application", w_api.RoleAdmin)
+
+		It("Should be allowed", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+		})
+	})
+	Context("Check the health as user", func() {
+		resp := get("/health")
+
+		It("Should be allowed", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+		})
+	})
+})
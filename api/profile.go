@@ -17,31 +17,44 @@ func (a *API) SetProfileAbout(ctx *fasthttp.RequestCtx) {
 		logger.Warn("Unable to set profile about", "error", err)
 		return
 	}
-	a.Config.ProfileAbout = about
+	a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		cfg.ProfileAbout = about
+		return nil
+	})
 }
 
 func (a *API) GetProfileAbout(ctx *fasthttp.RequestCtx) {
-	returnJSON(ctx, 200, a.Config.ProfileAbout)
+	returnJSON(ctx, 200, a.Config.Current().ProfileAbout)
 }
 
 func (a *API) SetProfilePhoto(ctx *fasthttp.RequestCtx) {
 	profilePhotoFilename := "pp_" + uuid.New().String()
+	uploadDir := a.Config.Current().UploadDir
 
-	if !savePostBody(ctx, profilePhotoFilename) {
+	if !savePostBody(ctx, filepath.Join(uploadDir, profilePhotoFilename)) {
 		return
 	}
 
-	if a.Config.ProfilePhotoFilename != "" {
-		// a profile picture already exists, delete it as not required anymore
-		_ = os.Remove(a.Config.UploadDir + a.Config.ProfilePhotoFilename)
+	var previousFilename string
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		previousFilename = cfg.ProfilePhotoFilename
+		cfg.ProfilePhotoFilename = profilePhotoFilename
+		return nil
+	}); !ok {
+		_ = os.Remove(filepath.Join(uploadDir, profilePhotoFilename))
+		return
 	}
 
-	a.Config.ProfilePhotoFilename = profilePhotoFilename
+	if previousFilename != "" {
+		// a profile picture already exists, delete it as not required anymore
+		_ = os.Remove(filepath.Join(uploadDir, previousFilename))
+	}
 	ctx.SetStatusCode(201)
 }
 
 func (a *API) GetProfilePhoto(ctx *fasthttp.RequestCtx) {
-	respondWithFile(ctx, 200, filepath.Join(a.Config.UploadDir, a.Config.ProfilePhotoFilename))
+	cfg := a.Config.Current()
+	respondWithFile(ctx, 200, filepath.Join(cfg.UploadDir, cfg.ProfilePhotoFilename))
 }
 
 func (a *API) SetBusinessProfile(ctx *fasthttp.RequestCtx) {
@@ -52,15 +65,21 @@ func (a *API) SetBusinessProfile(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	proto.Merge(a.Config.BusinessProfile, businessProfile)
+	a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		if cfg.BusinessProfile == nil {
+			cfg.BusinessProfile = &model.BusinessProfile{}
+		}
+		proto.Merge(cfg.BusinessProfile, businessProfile)
 
-	// WhatsApp only allows 2 urls. Therefore, only persist the last 2 and ignore the rest
-	countWebsites := len(a.Config.BusinessProfile.Websites)
-	if countWebsites > 2 {
-		a.Config.BusinessProfile.Websites = a.Config.BusinessProfile.Websites[countWebsites-2:]
-	}
+		// WhatsApp only allows 2 urls. Therefore, only persist the last 2 and ignore the rest
+		countWebsites := len(cfg.BusinessProfile.Websites)
+		if countWebsites > 2 {
+			cfg.BusinessProfile.Websites = cfg.BusinessProfile.Websites[countWebsites-2:]
+		}
+		return nil
+	})
 }
 
 func (a *API) GetBusinessProfile(ctx *fasthttp.RequestCtx) {
-	returnJSON(ctx, 200, a.Config.BusinessProfile)
+	returnJSON(ctx, 200, a.Config.Current().BusinessProfile)
 }
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"github.com/valyala/fasthttp"
+
+	log "github.com/ron96G/go-common-utils/log"
+)
+
+const (
+	QualityEventType = "quality_update"
+
+	QualityEventFlagged   = "FLAGGED"
+	QualityEventUnflagged = "UNFLAGGED"
+	QualityEventDowngrade = "DOWNGRADE"
+	QualityEventUpdate    = "UPDATE"
+)
+
+var (
+	// defaults which are used if the signals do not define them
+	DefaultQualityWindow          = 100
+	DefaultQualityYellowThreshold = 0.05
+	DefaultQualityRedThreshold    = 0.1
+
+	tierDowngrades = map[model.MessagingTier]model.MessagingTier{
+		model.MessagingTier_TIER_UNLIMITED: model.MessagingTier_TIER_100K,
+		model.MessagingTier_TIER_100K:      model.MessagingTier_TIER_10K,
+		model.MessagingTier_TIER_10K:       model.MessagingTier_TIER_1K,
+		model.MessagingTier_TIER_1K:        model.MessagingTier_TIER_1K,
+	}
+)
+
+// QualityModel derives the quality rating of the phone number from the signals of the last sent messages.
+// Once the rating becomes RED, the phone number is flagged and the messaging tier is lowered.
+// All changes are sent as account events to the webhook.
+type QualityModel struct {
+	Config   *SharedConfig
+	Tiers    *TierLimiter
+	Webhook  *webhook.Webhook
+	Log      log.Logger
+	outcomes []bool
+	next     int
+	count    int
+	negative int
+	rating   model.QualityRating
+	flagged  bool
+	mux      sync.Mutex
+}
+
+func NewQualityModel(cfg *SharedConfig, tiers *TierLimiter, wh *webhook.Webhook) *QualityModel {
+	q := &QualityModel{
+		Config:  cfg,
+		Tiers:   tiers,
+		Webhook: wh,
+		Log:     log.New("quality_logger", "component", "quality"),
+	}
+	q.mux.Lock()
+	events := q.reset()
+	q.mux.Unlock()
+	q.emit(events)
+	return q
+}
+
+// SetSignals replaces the signals, resets the evaluated messages and updates the rating
+func (q *QualityModel) SetSignals(signals *model.QualitySignals) error {
+	if signals == nil {
+		signals = &model.QualitySignals{}
+	}
+
+	q.mux.Lock()
+	_, err := q.Config.Update(func(cfg *model.InternalConfig) error {
+		cfg.QualitySignals = proto.Clone(signals).(*model.QualitySignals)
+		return nil
+	})
+	if err != nil {
+		q.mux.Unlock()
+		return err
+	}
+	events := q.reset()
+	q.mux.Unlock()
+
+	q.emit(events)
+	return nil
+}
+
+// reset discards the evaluated messages and updates the rating. The caller must hold the lock.
+func (q *QualityModel) reset() []*model.AccountEvent {
+	window := int(q.signals().Window)
+	if window <= 0 {
+		window = DefaultQualityWindow
+	}
+	q.outcomes = make([]bool, window)
+	q.next, q.count, q.negative = 0, 0, 0
+	return q.evaluate()
+}
+
+// signals returns the signals of the current config
+func (q *QualityModel) signals() *model.QualitySignals {
+	if signals := q.Config.Current().QualitySignals; signals != nil {
+		return signals
+	}
+	return &model.QualitySignals{}
+}
+
+// Record evaluates the signals for a sent message and updates the rating.
+// It returns whether the message fails, in which case a failed status is sent instead of the delivery.
+func (q *QualityModel) Record() (failed bool) {
+	q.mux.Lock()
+	signals := q.signals()
+	failed = chance(signals.FailureRate)
+	negative := failed || chance(signals.BlockRate)
+
+	if q.count == len(q.outcomes) {
+		if q.outcomes[q.next] {
+			q.negative--
+		}
+	} else {
+		q.count++
+	}
+	q.outcomes[q.next] = negative
+	if negative {
+		q.negative++
+	}
+	q.next = (q.next + 1) % len(q.outcomes)
+
+	events := q.evaluate()
+	q.mux.Unlock()
+
+	q.emit(events)
+	return failed
+}
+
+// Get returns the current quality state
+func (q *QualityModel) Get() *model.Quality {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	return &model.Quality{
+		Rating:    q.rating,
+		Flagged:   q.flagged,
+		Tier:      q.Tiers.Tier(),
+		Score:     q.score(),
+		Evaluated: int32(q.count),
+		Signals:   proto.Clone(q.signals()).(*model.QualitySignals),
+	}
+}
+
+func (q *QualityModel) score() float64 {
+	if q.count == 0 {
+		return 0
+	}
+	return float64(q.negative) / float64(q.count)
+}
+
+// evaluate updates the rating and returns the account events of the change.
+// The caller must hold the lock.
+func (q *QualityModel) evaluate() []*model.AccountEvent {
+	signals := q.signals()
+	rating := model.QualityRating_GREEN
+
+	if signals.Override {
+		rating = signals.OverrideRating
+	} else {
+		yellow, red := signals.YellowThreshold, signals.RedThreshold
+		if yellow == 0 {
+			yellow = DefaultQualityYellowThreshold
+		}
+		if red == 0 {
+			red = DefaultQualityRedThreshold
+		}
+		switch score := q.score(); {
+		case score >= red:
+			rating = model.QualityRating_RED
+		case score >= yellow:
+			rating = model.QualityRating_YELLOW
+		}
+	}
+
+	previous := q.rating
+	if rating == previous {
+		return nil
+	}
+	q.rating = rating
+	q.Log.Info("Quality rating changed", "rating", rating.String(), "previous", previous.String())
+
+	tier := q.Tiers.Tier()
+	newEvent := func(event string) *model.AccountEvent {
+		return &model.AccountEvent{
+			Type:                  QualityEventType,
+			Event:                 event,
+			QualityRating:         rating,
+			PreviousQualityRating: previous,
+			CurrentLimit:          tier,
+			Timestamp:             time.Now().Unix(),
+		}
+	}
+
+	switch {
+	case rating == model.QualityRating_RED:
+		q.flagged = true
+		events := []*model.AccountEvent{newEvent(QualityEventFlagged)}
+		if downgraded := tierDowngrades[tier]; downgraded != tier {
+			tier = downgraded
+			q.Tiers.SetTier(tier)
+			if _, err := q.Config.Update(func(cfg *model.InternalConfig) error {
+				cfg.MessagingTier = tier
+				return nil
+			}); err != nil {
+				q.Log.Error("Unable to persist messaging tier", "error", err)
+			}
+			q.Log.Warn("Downgraded messaging tier", "tier", tier.String())
+			events = append(events, newEvent(QualityEventDowngrade))
+		}
+		return events
+
+	case q.flagged:
+		q.flagged = false
+		return []*model.AccountEvent{newEvent(QualityEventUnflagged)}
+
+	default:
+		return []*model.AccountEvent{newEvent(QualityEventUpdate)}
+	}
+}
+
+func (q *QualityModel) emit(events []*model.AccountEvent) {
+	if err := q.Webhook.AddAccountEvents(events...); err != nil {
+		q.Log.Warn("Unable to send account events", "error", err)
+	}
+}
+
+func chance(probability float64) bool {
+	return probability > 0 && rand.Float64() < probability
+}
+
+// GetQuality godoc
+// @Summary Get the quality rating of the phone number
+// @Tags settings
+// @Produce json
+// @Success 200 {object} model.Quality
+// @Failure default {object} model.ErrorResponse
+// @Router /settings/account/quality [get]
+// @Security BearerAuth
+func (a *API) GetQuality(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Quality.Get())
+}
+
+// SetQualitySignals godoc
+// @Summary Configure the signals of the quality rating
+// @Description Set the block and failure rates of the simulated recipients or override the quality rating
+// @Tags mock
+// @Consume json
+// @Produce json
+// @Param body body model.QualitySignals true "the quality signals"
+// @Success 200 {object} model.Quality
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/settings/account/quality [post]
+// @Security ControlAuth
+func (a *API) SetQualitySignals(ctx *fasthttp.RequestCtx) {
+	signals := &model.QualitySignals{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, signals); err != nil {
+		logger.Warn("Unable to set quality signals", "error", err)
+		return
+	}
+
+	if signals.RedThreshold > 0 && signals.RedThreshold < signals.YellowThreshold {
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, "redThreshold must be greater than or equal to yellowThreshold")
+		return
+	}
+
+	if err := a.Quality.SetSignals(signals); err != nil {
+		logger.Error("Unable to persist config", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, "Unable to persist the state")
+		return
+	}
+	returnJSON(ctx, 200, a.Quality.Get())
+}
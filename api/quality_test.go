@@ -0,0 +1,75 @@
+package api_test
+
+import (
+	"bytes"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Quality API", func() {
+	defer GinkgoRecover()
+
+	setSignals := func(body string) (*http.Response, *model.Quality) {
+		resp, err := client.Do(NewControlRequest("POST", "/settings/account/quality", bytes.NewBufferString(body)))
+		PanicIfNotNil(err)
+
+		quality := new(model.Quality)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, quality))
+		return resp, quality
+	}
+
+	Context("Override the rating with RED", func() {
+		tier := api.Tiers.Tier()
+		resp, quality := setSignals(`{"override": true, "overrideRating": "RED"}`)
+		stored := api.Config.Current().MessagingTier
+
+		It("Should flag the phone number and downgrade the tier", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(quality.Rating).To(Equal(model.QualityRating_RED))
+			Expect(quality.Flagged).To(BeTrue())
+			Expect(quality.Tier).ToNot(Equal(tier))
+			Expect(stored).To(Equal(quality.Tier))
+		})
+	})
+
+	Context("Fail all messages", func() {
+		authToken, err := api.GenerateToken("admin", "ADMIN")
+		PanicIfNotNil(err)
+		signalsResp, _ := setSignals(`{"failureRate": 1}`)
+
+		req, _ := http.NewRequest("POST", baseUrl+"/messages", bytes.NewBufferString(`{"to": "491701223124", "type": "text", "text": {"body": "Hello"}}`))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+		idResp := new(model.IdResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, idResp))
+
+		req, _ = http.NewRequest("GET", baseUrl+"/messages/"+idResp.Messages[0].Id, nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err = client.Do(req)
+		PanicIfNotNil(err)
+		stored := new(model.WebhookRequest)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, stored))
+
+		It("Should send a failed status with the error instead of the delivery", func() {
+			Expect(signalsResp.StatusCode).To(Equal(200))
+			Expect(stored.Statuses).To(HaveLen(1))
+			Expect(stored.Statuses[0].Status).To(Equal(model.Status_failed))
+			Expect(stored.Statuses[0].Errors).To(HaveLen(1))
+			Expect(stored.Statuses[0].Errors[0].Code).To(Equal(model.ErrReceiverIncapable.Code))
+		})
+	})
+
+	Context("Remove the override", func() {
+		resp, quality := setSignals(`{}`)
+
+		It("Should unflag the phone number", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(quality.Rating).To(Equal(model.QualityRating_GREEN))
+			Expect(quality.Flagged).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+
+	log "github.com/ron96G/go-common-utils/log"
+)
+
+var (
+	// CodeValidDuration is the duration after which a requested code expires
+	CodeValidDuration = 10 * time.Minute
+	// CodeRequestCooldown is the duration which has to pass before a new code can be requested
+	CodeRequestCooldown = time.Minute
+	// MaxVerifyAttempts is the number of wrong codes after which the code is invalidated
+	MaxVerifyAttempts int32 = 3
+	// RegistrationDelay is the duration between the verification and the completed registration
+	RegistrationDelay time.Duration = 0
+	// MaxInboxSize is the number of codes which are kept in the inbox of the fake phone
+	MaxInboxSize = 10
+
+	errNotVerified = errors.New("account is not verified")
+)
+
+// Registrar implements the registration of the account as state machine:
+// unregistered -> code_requested -> verified -> registered.
+// The state is kept in the config so that it survives restarts.
+type Registrar struct {
+	Config  *SharedConfig
+	Log     log.Logger
+	pending *time.Timer
+	stopped bool
+	mux     sync.Mutex
+}
+
+func NewRegistrar(cfg *SharedConfig) *Registrar {
+	return &Registrar{
+		Config: cfg,
+		Log:    log.New("registration_logger", "component", "registration"),
+	}
+}
+
+// registration returns the registration state of the config.
+// Configs without a registration state were registered if they are verified.
+func registration(cfg *model.InternalConfig) *model.Registration {
+	if cfg.Registration != nil {
+		return cfg.Registration
+	}
+	if cfg.Verified {
+		return &model.Registration{State: model.Registration_registered}
+	}
+	return &model.Registration{}
+}
+
+// update changes the registration state of the config.
+// If fn returns an error, the state is not changed.
+func (r *Registrar) update(fn func(cfg *model.InternalConfig, reg *model.Registration) error) error {
+	_, err := r.Config.Update(func(cfg *model.InternalConfig) error {
+		cfg.Registration = registration(cfg)
+		return fn(cfg, cfg.Registration)
+	})
+	return err
+}
+
+// RequestCode sends a new code to the fake phone of the number
+func (r *Registrar) RequestCode(req *model.RegistrationRequest) error {
+	now := time.Now()
+	return r.update(func(cfg *model.InternalConfig, reg *model.Registration) error {
+		if reg.State == model.Registration_code_requested && now.Before(time.Unix(reg.RequestedAt, 0).Add(CodeRequestCooldown)) {
+			return model.ErrTooManyRequests.Err("A code has been requested recently. Try again later")
+		}
+
+		code := &model.RegistrationCode{
+			Cc:          req.Cc,
+			PhoneNumber: req.PhoneNumber,
+			Method:      req.Method,
+			Code:        generateRandomCode(6),
+			SentAt:      now.Unix(),
+			ExpiresAt:   now.Add(CodeValidDuration).Unix(),
+		}
+
+		reg.State = model.Registration_code_requested
+		reg.Cc = req.Cc
+		reg.PhoneNumber = req.PhoneNumber
+		reg.Method = req.Method
+		reg.Code = code.Code
+		reg.CodeExpiresAt = code.ExpiresAt
+		reg.Attempts = 0
+		reg.RequestedAt = code.SentAt
+		reg.Inbox = append(reg.Inbox, code)
+		if len(reg.Inbox) > MaxInboxSize {
+			reg.Inbox = reg.Inbox[len(reg.Inbox)-MaxInboxSize:]
+		}
+		cfg.Verified = false
+
+		r.Log.Info("Sent registration code", "cc", req.Cc, "phone_number", req.PhoneNumber, "method", req.Method.String())
+		return nil
+	})
+}
+
+// Verify checks the code and completes the registration
+func (r *Registrar) Verify(code string) error {
+	// wrong codes change the state as well. Therefore, their error is returned after the update
+	var verifyErr error
+	err := r.update(func(cfg *model.InternalConfig, reg *model.Registration) error {
+		if reg.State != model.Registration_code_requested || reg.Code == "" {
+			return model.ErrInvalidRequest.Err("No registration code has been requested")
+		}
+		if time.Now().After(time.Unix(reg.CodeExpiresAt, 0)) {
+			reg.Code = ""
+			verifyErr = model.ErrParameterValueInvalid.Err("The registration code has expired. Request a new code")
+			return nil
+		}
+		if code != reg.Code {
+			reg.Attempts++
+			if reg.Attempts >= MaxVerifyAttempts {
+				reg.Code = ""
+				verifyErr = model.ErrTooManyRequests.Err("Too many wrong codes. Request a new code")
+				return nil
+			}
+			verifyErr = model.ErrParameterValueInvalid.Err("Wrong verification code")
+			return nil
+		}
+
+		reg.State = model.Registration_verified
+		reg.Code = ""
+		reg.Attempts = 0
+		r.Log.Info("Verified account", "cc", reg.Cc, "phone_number", reg.PhoneNumber)
+
+		if RegistrationDelay == 0 {
+			completeRegistration(cfg, reg)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if verifyErr == nil && RegistrationDelay > 0 {
+		r.mux.Lock()
+		if !r.stopped {
+			if r.pending != nil {
+				r.pending.Stop()
+			}
+			r.pending = time.AfterFunc(RegistrationDelay, r.complete)
+		}
+		r.mux.Unlock()
+	}
+	return verifyErr
+}
+
+func (r *Registrar) complete() {
+	err := r.update(func(cfg *model.InternalConfig, reg *model.Registration) error {
+		if reg.State != model.Registration_verified {
+			return errNotVerified
+		}
+		completeRegistration(cfg, reg)
+		r.Log.Info("Registered account")
+		return nil
+	})
+	if err != nil && err != errNotVerified {
+		r.Log.Error("Unable to complete registration", "error", err)
+	}
+}
+
+// Stop cancels a pending registration. Later verifications do not complete the registration anymore.
+func (r *Registrar) Stop() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.stopped = true
+	if r.pending != nil {
+		r.pending.Stop()
+	}
+}
+
+// completeRegistration finishes the registration of a verified account
+func completeRegistration(cfg *model.InternalConfig, reg *model.Registration) {
+	reg.State = model.Registration_registered
+	cfg.Verified = true
+}
+
+func (r *Registrar) Registered() bool {
+	return registration(r.Config.Current().InternalConfig).State == model.Registration_registered
+}
+
+// Inbox returns a copy of the codes which have been sent to the fake phone
+func (r *Registrar) Inbox() *model.RegistrationInbox {
+	inbox := &model.RegistrationInbox{}
+	for _, code := range registration(r.Config.Current().InternalConfig).Inbox {
+		inbox.Codes = append(inbox.Codes, proto.Clone(code).(*model.RegistrationCode))
+	}
+	return inbox
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+// ConfigWatchDelay is the duration to wait after a change of the config file before it is reloaded.
+// Editors often write a file in several steps.
+var ConfigWatchDelay = 500 * time.Millisecond
+
+// ConfigChange is a single change of a config field
+type ConfigChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// ReloadConfigFile reads the config file and applies it to the API
+func (a *API) ReloadConfigFile(path string) ([]ConfigChange, error) {
+	cfg, err := ReadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return a.Reload(cfg)
+}
+
+// Reload applies the contacts, inbound media, users and application settings of the config
+// to the running API, webhook and generators. The config is validated first.
+// If it is invalid, the current config is kept and an error is returned.
+// All other fields of the config require a restart.
+func (a *API) Reload(next *model.InternalConfig) ([]ConfigChange, error) {
+	if err := a.checkConfig(next); err != nil {
+		return nil, err
+	}
+
+	generators, err := model.NewGenerators(a.Config.Current().UploadDir, ContactsFromConfig(next), next.InboundMedia)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []ConfigChange
+	var revoked []string
+	_, err = a.Config.Update(func(cfg *model.InternalConfig) error {
+		if changes, err = diffConfig(cfg, next); err != nil {
+			return err
+		}
+		revoked = changedUsers(cfg.UserRecords, next.UserRecords)
+		cfg.Contacts = next.Contacts
+		cfg.InboundMedia = next.InboundMedia
+		cfg.UserRecords = next.UserRecords
+		cfg.ApplicationSettings = next.ApplicationSettings
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.Webhook.SetGenerators(generators)
+	a.revokeUsers(revoked)
+
+	for _, c := range changes {
+		a.Log.Info("Changed config", "field", c.Field, "old", c.Old, "new", c.New)
+	}
+	a.Log.Info("Reloaded config", "changes", len(changes))
+	return changes, nil
+}
+
+// checkConfig validates a config which replaces the current config.
+// If the config has no webhook URL, the current URL is kept.
+func (a *API) checkConfig(next *model.InternalConfig) error {
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	if next.ApplicationSettings == nil {
+		return fmt.Errorf("applicationSettings cannot be empty")
+	}
+	if next.ApplicationSettings.Webhooks == nil {
+		next.ApplicationSettings.Webhooks = &model.ApplicationSettings_Webhooks{}
+	}
+	if next.ApplicationSettings.Webhooks.Url == "" {
+		next.ApplicationSettings.Webhooks.Url = a.Webhook.Default().URL()
+	} else if _, err := url.Parse(next.ApplicationSettings.Webhooks.Url); err != nil {
+		return fmt.Errorf("invalid webhook url: %v", err)
+	}
+	if err := MigrateUsers(next, a.Config.Current().UserRecords); err != nil {
+		return err
+	}
+	if len(next.UserRecords) == 0 {
+		return fmt.Errorf("users cannot be empty")
+	}
+	return nil
+}
+
+// WatchConfigFile calls reload whenever the config file is written.
+// The directory of the file is watched as editors and config maps replace the file instead of writing it.
+// The returned function stops the watcher.
+func WatchConfigFile(path string, reload func()) (stop func() error, err error) {
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err = watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(ConfigWatchDelay, reload)
+
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+	return watcher.Close, nil
+}
+
+// diffConfig returns the changes of the reloadable fields. Passwords are not contained.
+func diffConfig(old, next *model.InternalConfig) ([]ConfigChange, error) {
+	changes := []ConfigChange{}
+	for field, values := range map[string][2]proto.Message{
+		"contacts":            {&model.InternalConfig{Contacts: old.Contacts}, &model.InternalConfig{Contacts: next.Contacts}},
+		"inboundMedia":        {&model.InternalConfig{InboundMedia: old.InboundMedia}, &model.InternalConfig{InboundMedia: next.InboundMedia}},
+		"applicationSettings": {old.ApplicationSettings, next.ApplicationSettings},
+	} {
+		o, err := toJSONValue(values[0])
+		if err != nil {
+			return nil, err
+		}
+		n, err := toJSONValue(values[1])
+		if err != nil {
+			return nil, err
+		}
+		if field != "applicationSettings" {
+			o, n = o.(map[string]interface{})[field], n.(map[string]interface{})[field]
+		}
+		diffValues(field, o, n, &changes)
+	}
+	diffUsers(old.UserRecords, next.UserRecords, &changes)
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+// diffUsers appends the added, removed and changed users. Passwords are masked.
+func diffUsers(old, next map[string]*model.UserRecord, changes *[]ConfigChange) {
+	for name, record := range next {
+		current, ok := old[name]
+		if !ok {
+			*changes = append(*changes, ConfigChange{Field: "users." + name, New: "added"})
+			continue
+		}
+		if !bytes.Equal(current.PasswordHash, record.PasswordHash) {
+			*changes = append(*changes, ConfigChange{Field: "users." + name, Old: "***", New: "***"})
+		}
+		if current.Role != record.Role {
+			*changes = append(*changes, ConfigChange{Field: "users." + name + ".role", Old: current.Role.String(), New: record.Role.String()})
+		}
+	}
+	for name := range old {
+		if _, ok := next[name]; !ok {
+			*changes = append(*changes, ConfigChange{Field: "users." + name, Old: "removed"})
+		}
+	}
+}
+
+// changedUsers returns the users which are removed or whose password or role is changed
+func changedUsers(old, next map[string]*model.UserRecord) []string {
+	var users []string
+	for name, current := range old {
+		record, ok := next[name]
+		if !ok || !bytes.Equal(current.PasswordHash, record.PasswordHash) || current.Role != record.Role {
+			users = append(users, name)
+		}
+	}
+	sort.Strings(users)
+	return users
+}
+
+// revokeUsers revokes all tokens of the users. Errors are logged as the change of the users has already been applied.
+func (a *API) revokeUsers(users []string) {
+	for _, user := range users {
+		revoked, err := a.Tokens.RevokeUser(user)
+		if err != nil {
+			a.Log.Error("Unable to persist tokens", "error", err)
+		} else if revoked > 0 {
+			a.Log.Info("Revoked tokens", "user", user, "tokens", revoked)
+		}
+	}
+}
+
+func toJSONValue(m proto.Message) (v interface{}, err error) {
+	buf := &bytes.Buffer{}
+	if err = (&jsonpb.Marshaler{OrigName: true, EmitDefaults: true}).Marshal(buf, m); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(buf.Bytes(), &v)
+	return v, err
+}
+
+// diffValues appends the changes between the decoded JSON values old and next.
+// Objects are compared field by field, all other values as a whole.
+func diffValues(field string, old, next interface{}, changes *[]ConfigChange) {
+	o, oIsMap := old.(map[string]interface{})
+	n, nIsMap := next.(map[string]interface{})
+	if !oIsMap || !nIsMap {
+		if !reflect.DeepEqual(old, next) {
+			*changes = append(*changes, ConfigChange{Field: field, Old: old, New: next})
+		}
+		return
+	}
+	for k, v := range n {
+		diffValues(field+"."+k, o[k], v, changes)
+	}
+	for k, v := range o {
+		if _, ok := n[k]; !ok {
+			diffValues(field+"."+k, v, nil, changes)
+		}
+	}
+}
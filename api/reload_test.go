@@ -0,0 +1,98 @@
+package api_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ = Describe("Config Reload", func() {
+	defer GinkgoRecover()
+
+	cfg := w_api.NewConfig()
+	cfg.UploadDir = "media/"
+	cfg.Users["admin"] = "secret"
+	cfg.Contacts = []*model.InternalContact{{Id: "491701223123", Name: "Peter P."}}
+	cfg.InboundMedia = map[string]string{"image": "image"}
+	cfg.ApplicationSettings.Webhooks.Url = "https://localhost:9000/webhook"
+	wh := webhook.NewWebhook(cfg.ApplicationSettings.Webhooks.Url, cfg.Version, generators)
+	reloadAPI := w_api.NewAPI(apiPrefix, staticAPIToken, uint(20), cfg, wh)
+
+	Context("Reload a valid config", func() {
+		next := proto.Clone(cfg).(*model.InternalConfig)
+		next.Contacts = []*model.InternalContact{{Id: "491701223199", Name: "New Contact"}}
+		next.ApplicationSettings.Webhooks.Url = "https://example.com/webhook"
+		next.ApplicationSettings.SentStatus = false
+
+		changes, err := reloadAPI.Reload(next)
+		fields := []string{}
+		for _, c := range changes {
+			fields = append(fields, c.Field)
+		}
+
+		It("Should apply the config to the live subsystems", func() {
+			Expect(err).To(BeNil())
+			Expect(reloadAPI.Config.Current().Contacts).To(HaveLen(1))
+			Expect(wh.Default().URL()).To(Equal("https://example.com/webhook"))
+			Expect(wh.CurrentGenerators().Contacts[0].WaId).To(Equal("491701223199"))
+		})
+
+		It("Should return the diff", func() {
+			Expect(fields).To(ContainElements("applicationSettings.webhooks.url", "applicationSettings.sent_status"))
+		})
+	})
+
+	Context("Reload an invalid config", func() {
+		next := proto.Clone(cfg).(*model.InternalConfig)
+		next.Contacts = nil
+		next.ApplicationSettings.Webhooks.Url = "https://invalid.example.com/webhook"
+
+		_, err := reloadAPI.Reload(next)
+
+		It("Should keep the current config", func() {
+			Expect(err).ToNot(BeNil())
+			Expect(reloadAPI.Config.Current().Contacts).To(HaveLen(1))
+			Expect(wh.Default().URL()).To(Equal("https://example.com/webhook"))
+		})
+	})
+
+	Context("Reload plaintext users after a password change", func() {
+		hash, err := bcrypt.GenerateFromPassword([]byte("Changed123!"), bcrypt.MinCost)
+		PanicIfNotNil(err)
+		_, err = reloadAPI.Config.Update(func(c *model.InternalConfig) error {
+			c.UserRecords["admin"] = &model.UserRecord{PasswordHash: hash, Role: model.User_ADMIN}
+			c.UserRecords["operator"] = &model.UserRecord{PasswordHash: hash, Role: model.User_USER}
+			return nil
+		})
+		PanicIfNotNil(err)
+		adminToken, err := reloadAPI.GenerateToken("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		operatorToken, err := reloadAPI.GenerateToken("operator", w_api.RoleUser)
+		PanicIfNotNil(err)
+
+		next := proto.Clone(reloadAPI.Config.Current().InternalConfig).(*model.InternalConfig)
+		next.UserRecords = nil
+		next.Users = map[string]string{"admin": "secret"}
+		_, err = reloadAPI.Reload(next)
+
+		records := reloadAPI.Config.Current().UserRecords
+		_, adminErr := reloadAPI.Tokens.Validate(adminToken)
+		_, operatorErr := reloadAPI.Tokens.Validate(operatorToken)
+
+		It("Should keep the changed password", func() {
+			Expect(err).To(BeNil())
+			Expect(records).To(HaveLen(1))
+			Expect(records["admin"].PasswordHash).To(Equal(hash))
+			Expect(adminErr).To(BeNil())
+		})
+
+		It("Should revoke the tokens of removed users", func() {
+			Expect(records).ToNot(HaveKey("operator"))
+			Expect(operatorErr).ToNot(BeNil())
+		})
+	})
+})
@@ -2,12 +2,13 @@ package api
 
 import (
 	"os"
+	"sync"
 	"time"
 
 	"github.com/fasthttp/router"
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
 	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
-	"github.com/ron96G/whatsapp-bizapi-mock/util"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
 	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
 	"github.com/valyala/fasthttp"
 
@@ -38,30 +39,78 @@ var (
 )
 
 type API struct {
-	Server       *fasthttp.Server
-	Status       string
-	Config       *model.InternalConfig
-	Tokens       *util.Set
-	Webhook      *webhook.Webhook
-	RequestLimit uint
-	Log          log.Logger
-	cancel       chan int
+	Server *fasthttp.Server
+	// ControlServer only serves the control endpoints
+	ControlServer *fasthttp.Server
+	Status        string
+	Config        *SharedConfig
+	Tokens        *TokenStore
+	Messages      *MessageStore
+	Faults        *FaultInjector
+	Tiers         *TierLimiter
+	Quality       *QualityModel
+	Gateway       *Gateway
+	Registration  *Registrar
+	Permissions   *Permissions
+	Logins        *LoginGuard
+	Tenants       *Tenants
+	Store         store.Store
+	Webhook       *webhook.Webhook
+	RequestLimit  uint
+	Log           log.Logger
+	cancel        chan int
+	done          chan struct{}
+	stopOnce      sync.Once
+
+	apiPrefix     string
+	controlAPIKey string
 }
 
-func NewAPI(apiPrefix, staticApiToken string, requestLimit uint, cfg *model.InternalConfig, webhook *webhook.Webhook) *API {
+func NewAPI(apiPrefix, controlAPIKey string, requestLimit uint, cfg *model.InternalConfig, webhook *webhook.Webhook) *API {
+	migrateErr := MigrateUsers(cfg, nil)
+	config := NewSharedConfig(cfg)
 	api := &API{
 		Status:       model.Meta_experimental.String(),
-		Config:       cfg,
-		Tokens:       util.NewSet(),
+		Config:       config,
+		Tokens:       NewTokenStore(SigningKey, TokenValidDuration),
+		Messages:     NewMessageStore(),
+		Faults:       NewFaultInjector(),
+		Gateway:      NewGateway(),
+		Registration: NewRegistrar(config),
+		Permissions:  NewPermissions(DefaultPermissions),
+		Logins:       NewLoginGuard(LoginMaxUserFailures, LoginMaxIPFailures, LoginLockoutDuration),
+		Tiers:        NewTierLimiter(cfg.MessagingTier),
+		Store:        store.NewMemoryStore(),
 		Webhook:      webhook,
 		RequestLimit: requestLimit,
 		Log:          log.New("api_logger", "component", "api"),
 		cancel:       make(chan int, 1),
+		done:         make(chan struct{}),
 	}
-	api.NewServer(apiPrefix, staticApiToken)
+	if migrateErr != nil {
+		api.Log.Error("Unable to migrate users", "error", migrateErr)
+	}
+	api.Tokens.Store = api.Store
+	api.Quality = NewQualityModel(config, api.Tiers, webhook)
+	config.Persist = api.persistConfig
+	api.renewWebhookClient(&ConfigSnapshot{InternalConfig: &model.InternalConfig{}}, config.Current())
+	config.Watch(api.renewWebhookClient)
+	config.Watch(api.updateWebhookURL)
+	config.Watch(api.updateWebhookChaos)
+	config.Watch(api.updateMessagingTier)
+	api.NewServer(apiPrefix, controlAPIKey)
 	return api
 }
 
+// Stop ends the background work of the API, i.e. the periodic generation of webhook requests
+// and a pending registration. The webhook is stopped by the channel which is returned by its Run.
+func (a *API) Stop() {
+	a.stopOnce.Do(func() {
+		close(a.done)
+		a.Registration.Stop()
+	})
+}
+
 // @title WhatsAppMockServer API
 // @version 0.1
 // @description The WhatsAppMockServer offers a mock API for the WhatsApp-Business-API
@@ -74,29 +123,38 @@ func NewAPI(apiPrefix, staticApiToken string, requestLimit uint, cfg *model.Inte
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
+// @securityDefinitions.apikey ControlAuth
+// @in header
+// @name X-API-Key
 // @BasePath /v1
-func (a *API) NewServer(apiPrefix string, staticApiToken string) {
+func (a *API) NewServer(apiPrefix string, controlAPIKey string) {
+	a.apiPrefix = apiPrefix
+	a.controlAPIKey = controlAPIKey
 	r := router.New()
 	r.RedirectFixedPath = false
 	r.RedirectTrailingSlash = false
+	// the matched route selects the permissions of the request
+	r.SaveMatchedRoutePath = true
 	subR := r.Group(apiPrefix)
 
 	// general resources
-	subR.POST("/generate", Limiter(a.GenerateWebhookRequests, 2))
-	subR.POST("/generate/cancel", a.CancelGenerateWebhookRquests)
 	subR.POST("/messages", monitoring.All(Limiter(a.Authorize(a.SendMessages), a.RequestLimit)))
+	subR.GET("/messages/{id}", monitoring.All(a.Authorize(a.RetrieveMessage)))
 	subR.POST("/contacts", monitoring.All(Limiter(a.Authorize(a.Contacts), a.RequestLimit)))
 
-	subR.GET("/health", Limiter(AuthorizeStaticToken(HealthCheck, staticApiToken), 5))
+	subR.GET("/health", Limiter(a.Authorize(a.HealthCheck), 5))
 
 	// User resources
 	subR.POST("/users/login", monitoring.All(a.Login))
 	subR.POST("/users/logout", monitoring.All(a.Authorize(a.Logout)))
-	subR.POST("/users", monitoring.All(a.AuthorizeWithRoles(a.CreateUser, []string{"ADMIN"})))
-	subR.DELETE("/users/{name}", monitoring.All(a.AuthorizeWithRoles(a.DeleteUser, []string{"ADMIN"})))
+	subR.POST("/users", monitoring.All(a.Authorize(a.CreateUser)))
+	subR.GET("/users", monitoring.All(a.Authorize(a.ListUsers)))
+	subR.GET("/users/{name}", monitoring.All(a.Authorize(a.GetUser)))
+	subR.PUT("/users/{name}", monitoring.All(a.Authorize(a.UpdateUser)))
+	subR.DELETE("/users/{name}", monitoring.All(a.Authorize(a.DeleteUser)))
 
 	// Media resources
-	subR.POST("/media", monitoring.All(a.Authorize(SaveMedia)))
+	subR.POST("/media", monitoring.All(a.Authorize(a.SaveMedia)))
 	subR.GET("/media/{id}", monitoring.All(a.Authorize(a.RetrieveMedia)))
 	subR.DELETE("/media/{id}", monitoring.All(a.Authorize(a.DeleteMedia)))
 
@@ -105,12 +163,16 @@ func (a *API) NewServer(apiPrefix string, staticApiToken string) {
 	subR.GET("/settings/application", monitoring.All(a.Authorize(a.GetApplicationSettings)))
 	subR.DELETE("/settings/application", monitoring.All(a.Authorize(ResetApplicationSettings)))
 	subR.POST("/certificates/webhooks/ca", monitoring.All(a.Authorize(a.UploadWebhookCA)))
+	subR.POST("/certificates/webhooks/client", monitoring.All(a.Authorize(a.UploadWebhookClientCert)))
 	subR.POST("/settings/backup", monitoring.All(a.Authorize(a.BackupSettings)))
 	subR.POST("/settings/restore", monitoring.All(a.Authorize(a.RestoreSettings)))
 
 	// registration resources
 	subR.POST("/account/verify", monitoring.All(a.Authorize(a.VerifyAccount)))
 	subR.POST("/account", monitoring.All(a.Authorize(a.RegisterAccount)))
+	subR.POST("/settings/account/two-step", monitoring.All(a.Authorize(a.SetTwoStepPin)))
+	subR.DELETE("/settings/account/two-step", monitoring.All(a.Authorize(a.DisableTwoStepPin)))
+	subR.GET("/settings/account/quality", monitoring.All(a.Authorize(a.GetQuality)))
 
 	// profile resources
 	subR.PATCH("/settings/profile/about", monitoring.All(a.Authorize(a.SetProfileAbout)))
@@ -124,23 +186,47 @@ func (a *API) NewServer(apiPrefix string, staticApiToken string) {
 	subR.ANY("/stickerpacks/{path:*}", monitoring.All(NotImplementedHandler))
 
 	// stats resources
+	subR.GET("/stats/messaging", monitoring.All(a.Authorize(a.GetMessagingUsage)))
+	subR.GET("/stats/app", monitoring.All(a.Authorize(a.GetAppStats)))
+	subR.GET("/stats/db", monitoring.All(a.Authorize(a.GetDBStats)))
 	subR.ANY("/stats/{path:*}", monitoring.All(NotImplementedHandler))
 	subR.GET("/metrics", monitoring.All(monitoring.PrometheusHandler))
 
+	r.GET("/swagger/doc.json", SwaggerDoc)
 	r.GET("/swagger/{path:*}", swagger.SwaggerHandler())
 	r.GET("/metrics", monitoring.All(monitoring.PrometheusHandler))
 
+	// the control endpoints are not part of the WhatsApp API
+	if !SeparateControlServer {
+		a.controlRoutes(r)
+	}
 	r.PanicHandler = a.PanicHandler
 
-	handler := a.SetConnID(r.Handler)
+	// the fault rules are not applied to the control endpoints which manage them
+	a.Server = newServer(a.middleware(a.Faults.Middleware(r.Handler, ControlPrefix)))
+
+	ctrlR := router.New()
+	ctrlR.RedirectFixedPath = false
+	ctrlR.RedirectTrailingSlash = false
+	a.controlRoutes(ctrlR)
+	ctrlR.PanicHandler = a.PanicHandler
+	a.ControlServer = newServer(a.middleware(ctrlR.Handler))
+}
+
+// middleware wraps the handler with the request ID, tracing and access logs
+func (a *API) middleware(handler fasthttp.RequestHandler) fasthttp.RequestHandler {
+	handler = a.SetConnID(handler)
 	if EnableTracing {
 		handler = Tracer(handler)
 	}
 	if EnableAccessLog {
 		handler = fh_mw.LoggerWithConfig(handler, loggerConfig)
 	}
+	return handler
+}
 
-	server := &fasthttp.Server{
+func newServer(handler fasthttp.RequestHandler) *fasthttp.Server {
+	return &fasthttp.Server{
 		Handler:                       handler,
 		Name:                          Servername,
 		Concurrency:                   256 * 1024,
@@ -157,6 +243,4 @@ func (a *API) NewServer(apiPrefix string, staticApiToken string) {
 		DisablePreParseMultipartForm:  true,
 		StreamRequestBody:             false,
 	}
-
-	a.Server = server
 }
@@ -18,35 +18,72 @@ func (a *API) SetApplicationSettings(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	parsedUrl, err := url.Parse(appSettings.Webhooks.Url)
-	if err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Title:   "Unable to parse request body",
-			Details: "Failed to parse uploaded webhook url",
-		})
-		return
-	}
+	var webhookURL string
+	if appSettings.Webhooks != nil && appSettings.Webhooks.Url != "" {
+		parsedUrl, err := url.Parse(appSettings.Webhooks.Url)
+		if err != nil {
+			returnErrorCode(ctx, model.ErrParameterValueInvalid, "Failed to parse uploaded webhook url")
+			return
+		}
 
-	if parsedUrl.Scheme != "https" {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Title:   "Unsupported scheme for webhook url",
-			Details: "Webhook scheme must be https",
-		})
-		return
+		if parsedUrl.Scheme != "https" {
+			returnErrorCode(ctx, model.ErrParameterValueInvalid, "Webhook scheme must be https")
+			return
+		}
+		webhookURL = parsedUrl.String()
 	}
 
-	proto.Merge(a.Config.ApplicationSettings, appSettings)
-	a.Config.ApplicationSettings.Media.AutoDownload = appSettings.Media.AutoDownload
+	fields := presentFields(ctx.PostBody())
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		current := cfg.ApplicationSettings
+		proto.Merge(current, appSettings)
+		if appSettings.Media != nil {
+			current.Media.AutoDownload = appSettings.Media.AutoDownload
+		}
+		if webhookURL != "" {
+			current.Webhooks.Url = webhookURL
+		}
 
-	a.Webhook.URL = parsedUrl.String()
-	a.Log.Info("Updated webhook URL", "url", a.Webhook.URL)
+		// proto.Merge ignores fields which are set to false. Therefore,
+		// all boolean settings contained in the request are set explicitly
+		for name, set := range map[string]func(){
+			"callback_persist":            func() { current.CallbackPersist = appSettings.CallbackPersist },
+			"pass_through":                func() { current.PassThrough = appSettings.PassThrough },
+			"sent_status":                 func() { current.SentStatus = appSettings.SentStatus },
+			"db_garbagecollector_enable":  func() { current.DbGarbagecollectorEnable = appSettings.DbGarbagecollectorEnable },
+			"notify_user_change_number":   func() { current.NotifyUserChangeNumber = appSettings.NotifyUserChangeNumber },
+			"show_security_notifications": func() { current.ShowSecurityNotifications = appSettings.ShowSecurityNotifications },
+		} {
+			if fields[name] {
+				set()
+			}
+		}
+		return nil
+	}); !ok {
+		return
+	}
 	returnJSON(ctx, 200, nil)
 }
 
 func (a *API) GetApplicationSettings(ctx *fasthttp.RequestCtx) {
-	returnJSON(ctx, 200, a.Config.ApplicationSettings)
+	returnJSON(ctx, 200, a.Config.Current().ApplicationSettings)
+}
+
+// updateWebhookURL sends the webhook requests to the URL of the application settings once it has been changed
+func (a *API) updateWebhookURL(previous, current *ConfigSnapshot) {
+	url := webhookURL(current.InternalConfig)
+	if url == "" || url == webhookURL(previous.InternalConfig) {
+		return
+	}
+	a.Webhook.Default().SetURL(url)
+	a.Log.Info("Updated webhook URL", "url", url)
+}
+
+func webhookURL(cfg *model.InternalConfig) string {
+	if cfg.ApplicationSettings == nil || cfg.ApplicationSettings.Webhooks == nil {
+		return ""
+	}
+	return cfg.ApplicationSettings.Webhooks.Url
 }
 
 func ResetApplicationSettings(ctx *fasthttp.RequestCtx) { notImplemented(ctx) }
@@ -58,16 +95,22 @@ func (a *API) BackupSettings(ctx *fasthttp.RequestCtx) {
 		logger.Warn("Unable to backup application settings", "error", err)
 		return
 	}
+	b, err := a.createBackup(req.IncludeMedia, req.IncludeProfilePhoto)
+	if err != nil {
+		a.Log.Error("Failed to create backup", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
 	buf := &bytes.Buffer{}
-	marsheler.Marshal(buf, a.Config)
+	if err = b.write(buf); err != nil {
+		a.Log.Error("Failed to create backup", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
 	ciphertext, err := util.Encrypt(req.Password, buf)
 	if err != nil {
 		a.Log.Error("Failed to encrypt settings", "error", err)
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Title:   "Unable to encrypt settings",
-			Details: err.Error(),
-		})
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
 		return
 	}
 	resp := &model.BackupResponse{
@@ -86,30 +129,24 @@ func (a *API) RestoreSettings(ctx *fasthttp.RequestCtx) {
 		logger.Warn("Unable to restore application settings", "error", err)
 		return
 	}
-	buf := bytes.NewBuffer(req.Data)
-	ciphertext, err := util.Decrypt(req.Password, buf)
+	plaintext, err := util.Decrypt(req.Password, bytes.NewBuffer(req.Data))
 	if err != nil {
 		a.Log.Error("Failed to decrypt settings", "error", err)
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Title:   "Unable to decrypt settings",
-			Details: err.Error(),
-		})
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
 		return
 	}
-	buf.Reset()
-	buf = bytes.NewBuffer(ciphertext)
-	cfg := new(model.InternalConfig)
-	err = unmarsheler.Unmarshal(buf, cfg)
+	b, err := readBackup(plaintext)
 	if err != nil {
-		a.Log.Error("Failed to update settings", "error", err)
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Title:   "Unable to set settings",
-			Details: err.Error(),
-		})
+		a.Log.Error("Failed to read backup", "error", err)
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
 		return
 	}
-	a.Config = cfg
-	ctx.SetStatusCode(200)
+
+	resp, err := a.restoreBackup(b, req.DryRun)
+	if err != nil {
+		a.Log.Error("Failed to restore settings", "error", err)
+		returnAPIError(ctx, err)
+		return
+	}
+	returnJSON(ctx, 200, resp)
 }
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+// ConfigSnapshot is a revision of the config. It is shared by all readers and must not be modified.
+type ConfigSnapshot struct {
+	*model.InternalConfig
+	Revision uint64
+}
+
+// SharedConfig synchronizes the access to the config of the API.
+// Readers get the current snapshot without locking. Writers modify a copy of it
+// which replaces the current snapshot as the next version (copy-on-write).
+// Updates are serialized so that no change is lost.
+type SharedConfig struct {
+	// Persist is called with each new version before it replaces the current snapshot.
+	// If it fails, the update is discarded.
+	Persist  func(cfg *model.InternalConfig) error
+	current  atomic.Value // *ConfigSnapshot
+	watchers []func(previous, current *ConfigSnapshot)
+	mux      sync.Mutex
+}
+
+func NewSharedConfig(cfg *model.InternalConfig) *SharedConfig {
+	c := &SharedConfig{}
+	c.current.Store(&ConfigSnapshot{InternalConfig: cfg, Revision: 1})
+	return c
+}
+
+// Current returns the current snapshot of the config
+func (c *SharedConfig) Current() *ConfigSnapshot {
+	return c.current.Load().(*ConfigSnapshot)
+}
+
+// Watch registers fn which is called after each update in the order of the versions.
+// It is used by the subsystems which derive their state from the config.
+func (c *SharedConfig) Watch(fn func(previous, current *ConfigSnapshot)) {
+	c.mux.Lock()
+	c.watchers = append(c.watchers, fn)
+	c.mux.Unlock()
+}
+
+// Update applies fn to a copy of the current config and stores it as next version.
+// If fn returns an error, the copy is discarded and the error is returned.
+func (c *SharedConfig) Update(fn func(cfg *model.InternalConfig) error) (*ConfigSnapshot, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	current := c.Current()
+	next := proto.Clone(current.InternalConfig).(*model.InternalConfig)
+	if err := fn(next); err != nil {
+		return current, err
+	}
+	return c.store(next)
+}
+
+// Replace stores cfg as next version. cfg must not be modified afterwards.
+func (c *SharedConfig) Replace(cfg *model.InternalConfig) (*ConfigSnapshot, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.store(cfg)
+}
+
+// store persists and publishes the snapshot. The caller must hold the lock.
+func (c *SharedConfig) store(cfg *model.InternalConfig) (*ConfigSnapshot, error) {
+	previous := c.Current()
+	if c.Persist != nil {
+		if err := c.Persist(cfg); err != nil {
+			return previous, err
+		}
+	}
+	snapshot := &ConfigSnapshot{InternalConfig: cfg, Revision: previous.Revision + 1}
+	c.current.Store(snapshot)
+	for _, watch := range c.watchers {
+		watch(previous, snapshot)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,59 @@
+package api_test
+
+import (
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Shared Config", func() {
+	defer GinkgoRecover()
+
+	cfg := w_api.NewConfig()
+	cfg.Users["admin"] = "secret"
+	shared := w_api.NewSharedConfig(cfg)
+	initial := shared.Current()
+
+	Context("Update the config concurrently", func() {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, err := shared.Update(func(cfg *model.InternalConfig) error {
+					cfg.Users[fmt.Sprintf("user%d", i)] = "password"
+					return nil
+				})
+				PanicIfNotNil(err)
+				_ = shared.Current().Users["admin"]
+			}(i)
+		}
+		wg.Wait()
+
+		It("Should keep all changes", func() {
+			Expect(shared.Current().Users).To(HaveLen(21))
+			Expect(shared.Current().Revision).To(Equal(initial.Revision + 20))
+		})
+
+		It("Should not modify the previous snapshots", func() {
+			Expect(initial.Users).To(HaveLen(1))
+		})
+	})
+
+	Context("Fail to update the config", func() {
+		current := shared.Current()
+		_, err := shared.Update(func(cfg *model.InternalConfig) error {
+			cfg.Users = nil
+			return model.ErrInvalidRequest.Err("invalid")
+		})
+
+		It("Should keep the current snapshot", func() {
+			Expect(err).ToNot(BeNil())
+			Expect(shared.Current()).To(Equal(current))
+		})
+	})
+})
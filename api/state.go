@@ -0,0 +1,63 @@
+package api
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// ConfigStateKey is the key of the config which contains the users, settings, profile and registration
+	ConfigStateKey = "config"
+	// TokensStateKey is the key of the raw tokens of older states which are migrated to sessions
+	TokensStateKey = "tokens"
+	// MediaStatePrefix is the prefix of the keys of the media metadata
+	MediaStatePrefix = "media/"
+)
+
+// LoadConfigState replaces the content of the config with the config of the store
+// if it has been stored before. It returns whether a stored config was found.
+func LoadConfigState(s store.Store, cfg *model.InternalConfig) (bool, error) {
+	stored := &model.InternalConfig{}
+	found, err := s.Get(ConfigStateKey, stored)
+	if err != nil || !found {
+		return false, err
+	}
+	cfg.Reset()
+	proto.Merge(cfg, stored)
+	return true, nil
+}
+
+// UseStore persists the state of the API in the store from now on.
+// The tokens and the generated signing key of the store are restored and the current config is written.
+func (a *API) UseStore(s store.Store) error {
+	if err := a.Tokens.UseStore(s); err != nil {
+		return err
+	}
+	a.Store = s
+	if err := a.Store.Put(ConfigStateKey, a.Config.Current().InternalConfig); err != nil {
+		return err
+	}
+	return a.Webhook.UseStore(store.WithPrefix(s, "webhook/"))
+}
+
+// persistConfig writes each new version of the config to the store
+func (a *API) persistConfig(cfg *model.InternalConfig) error {
+	return a.Store.Put(ConfigStateKey, cfg)
+}
+
+// updateConfig applies fn to the config of the API. If fn fails, its error is returned to the client.
+// If the new config cannot be persisted, an internal error is returned and the config is not changed.
+func (a *API) updateConfig(ctx *fasthttp.RequestCtx, fn func(cfg *model.InternalConfig) error) (*ConfigSnapshot, bool) {
+	snapshot, err := a.Config.Update(fn)
+	if apiErr, ok := err.(*model.APIError); ok {
+		returnAPIError(ctx, apiErr)
+		return snapshot, false
+	} else if err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to persist config", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, "Unable to persist the state")
+		return snapshot, false
+	}
+	return snapshot, true
+}
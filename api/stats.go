@@ -0,0 +1,193 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/valyala/fasthttp"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// GetAppStats godoc
+// @Summary Get the application stats
+// @Description Get the message, status and callback stats. Use format=prometheus to get the prometheus text format
+// @Tags stats
+// @Produce json
+// @Produce plain
+// @Param format query string false "json (default) or prometheus"
+// @Success 200 {object} model.StatsResponse
+// @Failure default {object} model.ErrorResponse
+// @Router /stats/app [get]
+// @Security BearerAuth
+func (a *API) GetAppStats(ctx *fasthttp.RequestCtx) {
+	data, err := monitoring.Gather(
+		monitoring.Messages,
+		monitoring.Statuses,
+		monitoring.WebhookQueueDepth,
+		monitoring.WebhookRequestDuration,
+	)
+	if err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to gather app stats", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, "Unable to gather stats")
+		return
+	}
+	// the collectors are shared by the tenants of the server
+	data = tenantMetrics(data, a.Webhook.Tenant)
+
+	if wantsPrometheus(ctx) {
+		returnPrometheus(ctx, data)
+		return
+	}
+
+	stats := &model.AppStats{
+		InboundMessages:   map[string]int64{},
+		OutboundMessages:  map[string]int64{},
+		Statuses:          map[string]int64{},
+		CallbackQueueSize: map[string]int64{},
+		Callbacks:         map[string]*model.CallbackStats{},
+	}
+	latencies := map[string]float64{}
+
+	for _, family := range data {
+		for _, m := range family.Metric {
+			labels := labelMap(m)
+			switch family.GetName() {
+			case "whatsapp_mock_messages":
+				if labels["direction"] == "inbound" {
+					stats.InboundMessages[labels["type"]] += int64(m.GetCounter().GetValue())
+				} else {
+					stats.OutboundMessages[labels["type"]] += int64(m.GetCounter().GetValue())
+				}
+
+			case "whatsapp_mock_statuses":
+				stats.Statuses[labels["status"]] += int64(m.GetCounter().GetValue())
+
+			case "whatsapp_mock_webhook_queue_depth":
+				stats.CallbackQueueSize[labels["target"]] = int64(m.GetGauge().GetValue())
+
+			case "whatsapp_mock_webhook_duration_seconds":
+				target := labels["target"]
+				cb, ok := stats.Callbacks[target]
+				if !ok {
+					cb = &model.CallbackStats{}
+					stats.Callbacks[target] = cb
+				}
+				count := int64(m.GetHistogram().GetSampleCount())
+				cb.Sent += count
+				if !strings.HasPrefix(labels["status"], "2") {
+					cb.Failed += count
+				}
+				latencies[target] += m.GetHistogram().GetSampleSum()
+			}
+		}
+	}
+	for target, cb := range stats.Callbacks {
+		if cb.Sent > 0 {
+			cb.AvgLatencySeconds = latencies[target] / float64(cb.Sent)
+		}
+	}
+
+	returnJSON(ctx, 200, &model.StatsResponse{
+		Meta:  statsMeta(),
+		Stats: &model.Stats{App: stats},
+	})
+}
+
+// GetDBStats godoc
+// @Summary Get the database stats
+// @Description Get the number of stored entities. Use format=prometheus to get the prometheus text format
+// @Tags stats
+// @Produce json
+// @Produce plain
+// @Param format query string false "json (default) or prometheus"
+// @Success 200 {object} model.StatsResponse
+// @Failure default {object} model.ErrorResponse
+// @Router /stats/db [get]
+// @Security BearerAuth
+func (a *API) GetDBStats(ctx *fasthttp.RequestCtx) {
+	cfg := a.Config.Current()
+	stats := &model.DBStats{
+		StoredMessages:   int64(a.Messages.Len()),
+		PendingStatuses:  int64(a.Webhook.PendingStati()),
+		Contacts:         int64(len(cfg.Contacts)),
+		Users:            int64(len(cfg.UserRecords)),
+		Sessions:         int64(a.Tokens.Len()),
+		UniqueRecipients: a.Tiers.Usage().UniqueRecipients,
+	}
+
+	if !wantsPrometheus(ctx) {
+		returnJSON(ctx, 200, &model.StatsResponse{
+			Meta:  statsMeta(),
+			Stats: &model.Stats{Db: stats},
+		})
+		return
+	}
+
+	gauges := []prometheus.Collector{}
+	for name, value := range map[string]int64{
+		"stored_messages":   stats.StoredMessages,
+		"pending_statuses":  stats.PendingStatuses,
+		"contacts":          stats.Contacts,
+		"users":             stats.Users,
+		"sessions":          stats.Sessions,
+		"unique_recipients": stats.UniqueRecipients,
+	} {
+		v := float64(value)
+		gauges = append(gauges, prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "whatsapp_mock",
+			Subsystem: "db",
+			Name:      name,
+			Help:      "The number of " + strings.ReplaceAll(name, "_", " ") + ".",
+		}, func() float64 { return v }))
+	}
+
+	data, err := monitoring.Gather(gauges...)
+	if err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to gather db stats", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, "Unable to gather stats")
+		return
+	}
+	returnPrometheus(ctx, data)
+}
+
+func wantsPrometheus(ctx *fasthttp.RequestCtx) bool {
+	return strings.ToLower(string(ctx.QueryArgs().Peek("format"))) == "prometheus"
+}
+
+func returnPrometheus(ctx *fasthttp.RequestCtx, data []*dto.MetricFamily) {
+	ctx.SetContentType("text/plain; version=0.0.4; charset=utf-8")
+	ctx.SetStatusCode(200)
+	monitoring.WriteText(ctx, data)
+}
+
+// tenantMetrics removes the metrics of the other tenants
+func tenantMetrics(data []*dto.MetricFamily, tenant string) []*dto.MetricFamily {
+	for _, family := range data {
+		metrics := family.Metric[:0]
+		for _, m := range family.Metric {
+			if labelMap(m)["tenant"] == tenant {
+				metrics = append(metrics, m)
+			}
+		}
+		family.Metric = metrics
+	}
+	return data
+}
+
+func labelMap(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.Label))
+	for _, l := range m.Label {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
+
+func statsMeta() *model.Meta {
+	return &model.Meta{
+		Version:   Version,
+		ApiStatus: ApiStatus,
+	}
+}
@@ -0,0 +1,66 @@
+package api_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Stats API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	Context("Get the app stats", func() {
+		req, _ := http.NewRequest("GET", baseUrl+"/stats/app", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		statsResp := new(model.StatsResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, statsResp))
+
+		It("Should contain the sent messages", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(statsResp.Stats.App.OutboundMessages["text"]).To(BeNumerically(">=", 1))
+		})
+	})
+
+	Context("Get the db stats in the prometheus format", func() {
+		req, _ := http.NewRequest("GET", baseUrl+"/stats/db?format=prometheus", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		body, err := ioutil.ReadAll(resp.Body)
+		PanicIfNotNil(err)
+
+		It("Should contain the number of users", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(string(body)).To(ContainSubstring("whatsapp_mock_db_users 1"))
+		})
+	})
+
+	Context("Get a stat which is not implemented", func() {
+		req, _ := http.NewRequest("GET", baseUrl+"/stats/unknown", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		errResp := new(model.ErrorResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, errResp))
+
+		It("Should respond with the resource not found error", func() {
+			Expect(resp.StatusCode).To(Equal(model.ErrResourceNotFound.StatusCode))
+			Expect(errResp.Errors).To(HaveLen(1))
+			Expect(errResp.Errors[0].Code).To(Equal(model.ErrResourceNotFound.Code))
+		})
+	})
+})
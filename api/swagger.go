@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/swaggo/swag"
+	"github.com/valyala/fasthttp"
+)
+
+// swaggerControlPrefix is the prefix of the control endpoints in the swagger annotations
+const swaggerControlPrefix = "/mock"
+
+// SwaggerDoc responds with the swagger documentation of the served endpoints.
+// Swagger 2.0 only supports a single base path, which is the API prefix.
+// The control endpoints are not served below it, therefore all paths are
+// made absolute and the control endpoints are moved to the ControlPrefix.
+func SwaggerDoc(ctx *fasthttp.RequestCtx) {
+	doc, err := swag.ReadDoc()
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+	absDoc, err := swaggerDoc(doc)
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.SetBody(absDoc)
+}
+
+func swaggerDoc(doc string) ([]byte, error) {
+	spec := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(doc), &spec); err != nil {
+		return nil, err
+	}
+	basePath := ""
+	if raw, ok := spec["basePath"]; ok {
+		if err := json.Unmarshal(raw, &basePath); err != nil {
+			return nil, err
+		}
+	}
+	paths := map[string]json.RawMessage{}
+	if raw, ok := spec["paths"]; ok {
+		if err := json.Unmarshal(raw, &paths); err != nil {
+			return nil, err
+		}
+	}
+
+	absPaths := make(map[string]json.RawMessage, len(paths))
+	for path, item := range paths {
+		if strings.HasPrefix(path, swaggerControlPrefix+"/") {
+			absPaths[ControlPrefix+strings.TrimPrefix(path, swaggerControlPrefix)] = item
+		} else {
+			absPaths[strings.TrimSuffix(basePath, "/")+path] = item
+		}
+	}
+
+	var err error
+	if spec["paths"], err = json.Marshal(absPaths); err != nil {
+		return nil, err
+	}
+	spec["basePath"] = json.RawMessage(`"/"`)
+	return json.Marshal(spec)
+}
@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/docs"
+)
+
+var _ = Describe("Swagger API", func() {
+	defer GinkgoRecover()
+
+	type swaggerSpec struct {
+		BasePath string                 `json:"basePath"`
+		Paths    map[string]interface{} `json:"paths"`
+	}
+
+	getDoc := func() (int, swaggerSpec) {
+		req, _ := http.NewRequest("GET", "http://localhost:8080/swagger/doc.json", nil)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		spec := swaggerSpec{}
+		PanicIfNotNil(json.NewDecoder(resp.Body).Decode(&spec))
+		return resp.StatusCode, spec
+	}
+
+	Context("Get the documentation with the default prefixes", func() {
+		status, spec := getDoc()
+
+		It("Should document the endpoints at their absolute paths", func() {
+			Expect(status).To(Equal(200))
+			Expect(spec.BasePath).To(Equal("/"))
+			Expect(spec.Paths).To(HaveKey("/v1/messages/{id}"))
+			Expect(spec.Paths).To(HaveKey("/v1/users/login"))
+			Expect(spec.Paths).To(HaveKey("/mock/webhook/chaos"))
+			Expect(spec.Paths).ToNot(HaveKey("/messages/{id}"))
+			Expect(spec.Paths).ToNot(HaveKey("/v1/mock/webhook/chaos"))
+		})
+	})
+
+	Context("Get the documentation with custom prefixes", func() {
+		basePath, controlPrefix := docs.SwaggerInfo.BasePath, w_api.ControlPrefix
+		docs.SwaggerInfo.BasePath, w_api.ControlPrefix = "/api/v1", "/control"
+		status, spec := getDoc()
+		docs.SwaggerInfo.BasePath, w_api.ControlPrefix = basePath, controlPrefix
+
+		It("Should document the endpoints at the configured prefixes", func() {
+			Expect(status).To(Equal(200))
+			Expect(spec.BasePath).To(Equal("/"))
+			Expect(spec.Paths).To(HaveKey("/api/v1/messages/{id}"))
+			Expect(spec.Paths).To(HaveKey("/control/webhook/chaos"))
+			Expect(spec.Paths).To(HaveKey("/control/admin/tenants/{name}"))
+			Expect(spec.Paths).ToNot(HaveKey("/v1/messages/{id}"))
+			Expect(spec.Paths).ToNot(HaveKey("/mock/webhook/chaos"))
+		})
+	})
+})
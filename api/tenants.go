@@ -0,0 +1,415 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"github.com/valyala/fasthttp"
+
+	log "github.com/ron96G/go-common-utils/log"
+)
+
+const (
+	// TenantHeader selects the tenant of a request
+	TenantHeader = "X-Tenant"
+	// TenantPathPrefix selects the tenant of a request by the first path segment after it
+	TenantPathPrefix = "/tenants/"
+)
+
+type tenant struct {
+	spec        *model.Tenant
+	api         *API
+	store       store.Store
+	stopWebhook chan int
+}
+
+// Tenants serves several isolated accounts in one server. Each tenant has its own
+// config, users, tokens, webhook, media directory and generators.
+// Requests are routed to a tenant by the TenantHeader, the TenantPathPrefix or the host name.
+// All other requests are handled by the API which owns the tenants.
+type Tenants struct {
+	api     *API
+	tenants map[string]*tenant
+	Log     log.Logger
+	errors  chan error
+	mux     sync.RWMutex
+}
+
+func NewTenants(a *API) *Tenants {
+	t := &Tenants{
+		api:     a,
+		tenants: map[string]*tenant{},
+		Log:     log.New("tenant_logger", "component", "tenants"),
+		errors:  make(chan error, 5),
+	}
+	go func() {
+		for err := range t.errors {
+			t.Log.Error("Async error occured", "error", err)
+		}
+	}()
+	return t
+}
+
+// EnableTenants routes the requests of the server to the tenants
+// and starts the tenants of the config
+func (a *API) EnableTenants() error {
+	a.Tenants = NewTenants(a)
+	a.Server.Handler = a.Tenants.Handler(a.Server.Handler)
+	a.ControlServer.Handler = a.Tenants.ControlHandler(a.ControlServer.Handler)
+	return a.Tenants.Load()
+}
+
+// Load starts all tenants which are configured in the config of the owning API
+func (t *Tenants) Load() error {
+	for _, spec := range t.api.Config.Current().Tenants {
+		if _, err := t.start(spec); err != nil {
+			return fmt.Errorf("tenant %s: %v", spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// Create starts a new tenant and adds it to the config of the owning API
+func (t *Tenants) Create(spec *model.Tenant) error {
+	spec, err := t.start(spec)
+	if err != nil {
+		return err
+	}
+	_, err = t.api.Config.Update(func(cfg *model.InternalConfig) error {
+		cfg.Tenants = append(cfg.Tenants, spec)
+		return nil
+	})
+	return err
+}
+
+// Remove stops the tenant and removes it from the config and the store. Its media directory is kept.
+func (t *Tenants) Remove(name string) bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	tn, ok := t.tenants[name]
+	if !ok {
+		return false
+	}
+	tn.stop()
+	delete(t.tenants, name)
+
+	keys, err := tn.store.Keys("")
+	for _, key := range keys {
+		if err == nil {
+			err = tn.store.Delete(key)
+		}
+	}
+	if err != nil {
+		t.Log.Error("Unable to delete the state of the tenant", "tenant", name, "error", err)
+	}
+
+	if _, err = t.api.Config.Update(func(cfg *model.InternalConfig) error {
+		specs := cfg.Tenants[:0]
+		for _, spec := range cfg.Tenants {
+			if spec.Name != name {
+				specs = append(specs, spec)
+			}
+		}
+		cfg.Tenants = specs
+		return nil
+	}); err != nil {
+		t.Log.Error("Unable to persist config", "error", err)
+	}
+	t.Log.Info("Removed tenant", "tenant", name)
+	return true
+}
+
+// Get returns the API of the tenant
+func (t *Tenants) Get(name string) (*API, bool) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	tn, ok := t.tenants[name]
+	if !ok {
+		return nil, false
+	}
+	return tn.api, true
+}
+
+// List returns the names and hosts of all tenants
+func (t *Tenants) List() *model.Tenants {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	list := &model.Tenants{}
+	for _, spec := range t.api.Config.Current().Tenants {
+		list.Tenants = append(list.Tenants, &model.Tenant{Name: spec.Name, Hosts: spec.Hosts})
+	}
+	return list
+}
+
+// Stop stops the APIs and webhooks of all tenants
+func (t *Tenants) Stop() {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	for _, tn := range t.tenants {
+		tn.stop()
+	}
+}
+
+// stop ends the webhook and the background work of the API of the tenant
+func (tn *tenant) stop() {
+	tn.stopWebhook <- 1
+	tn.api.Stop()
+}
+
+// apis returns the APIs of all tenants
+func (t *Tenants) apis() []*API {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	apis := make([]*API, 0, len(t.tenants))
+	for _, tn := range t.tenants {
+		apis = append(apis, tn.api)
+	}
+	return apis
+}
+
+func (t *Tenants) byHost(host string) *tenant {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	for _, tn := range t.tenants {
+		if contains(tn.spec.Hosts, host) {
+			return tn
+		}
+	}
+	return nil
+}
+
+// start creates the API and webhook of the tenant. Missing values of its config are set to
+// the defaults and the inbound media and contacts of the owning API are used if none are configured.
+func (t *Tenants) start(spec *model.Tenant) (*model.Tenant, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if _, exists := t.tenants[spec.Name]; exists {
+		return nil, model.ErrResourceAlreadyExists.Err(fmt.Sprintf("The tenant %s already exists", spec.Name))
+	}
+	for _, tn := range t.tenants {
+		for _, host := range spec.Hosts {
+			if contains(tn.spec.Hosts, host) {
+				return nil, model.ErrResourceAlreadyExists.Err(fmt.Sprintf("The host %s is already used by tenant %s", host, tn.spec.Name))
+			}
+		}
+	}
+
+	parent := t.api.Config.Current()
+	cfg := NewConfig()
+	cfg.Version = Version
+	cfg.Status = ApiStatus.String()
+	if spec.Config != nil {
+		proto.Merge(cfg, spec.Config)
+	}
+	// without users of the spec, the admin has the default password which has to be changed on the first login
+	if len(cfg.Users) == 0 && len(cfg.UserRecords) == 0 {
+		cfg.Users["admin"] = DefaultAdminPassword
+	}
+
+	// the stored state of the tenant takes precedence over its spec
+	tenantStore := store.WithPrefix(t.api.Store, "tenants/"+spec.Name+"/")
+	if _, err := LoadConfigState(tenantStore, cfg); err != nil {
+		return nil, err
+	}
+	// the spec belongs to the config of the owning API and must not be modified
+	spec = proto.Clone(spec).(*model.Tenant)
+	spec.Config = cfg
+
+	if cfg.UploadDir == "" {
+		cfg.UploadDir = filepath.Join(parent.UploadDir, "tenants", spec.Name) + "/"
+	}
+	if err := os.MkdirAll(cfg.UploadDir, 0700); err != nil {
+		return nil, err
+	}
+	if len(cfg.Contacts) == 0 {
+		for _, c := range parent.Contacts {
+			cfg.Contacts = append(cfg.Contacts, proto.Clone(c).(*model.InternalContact))
+		}
+	}
+	if len(cfg.InboundMedia) == 0 {
+		if err := linkInboundMedia(parent.InternalConfig, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	generators, err := model.NewGenerators(cfg.UploadDir, ContactsFromConfig(cfg), cfg.InboundMedia)
+	if err != nil {
+		return nil, err
+	}
+	wh := webhook.NewWebhook(cfg.ApplicationSettings.Webhooks.Url, cfg.Version, generators)
+	wh.Chaos.Set(cfg.WebhookChaos)
+	wh.SetTenant(spec.Name)
+
+	tn := &tenant{
+		spec:  spec,
+		api:   NewAPI(t.api.apiPrefix, t.api.controlAPIKey, t.api.RequestLimit, cfg, wh),
+		store: tenantStore,
+	}
+	tn.api.Log = tn.api.Log.New("tenant", spec.Name)
+	tn.api.Permissions = t.api.Permissions
+	if err := tn.api.UseStore(tenantStore); err != nil {
+		return nil, err
+	}
+	tn.stopWebhook = wh.Run(t.errors)
+	t.tenants[spec.Name] = tn
+
+	t.Log.Info("Started tenant", "tenant", spec.Name, "hosts", spec.Hosts, "upload_dir", cfg.UploadDir)
+	return spec, nil
+}
+
+// linkInboundMedia links the inbound media files of the source into the upload directory of the target
+func linkInboundMedia(src, dst *model.InternalConfig) error {
+	dst.InboundMedia = map[string]string{}
+	for k, f := range src.InboundMedia {
+		source, err := filepath.Abs(filepath.Join(src.UploadDir, f))
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst.UploadDir, f)
+		if _, err := os.Lstat(target); os.IsNotExist(err) {
+			if err := os.Symlink(source, target); err != nil {
+				return err
+			}
+		}
+		dst.InboundMedia[k] = f
+	}
+	return nil
+}
+
+// ContactsFromConfig returns the contacts of the config which are used to generate inbound messages
+func ContactsFromConfig(cfg *model.InternalConfig) []*model.Contact {
+	contacts := make([]*model.Contact, len(cfg.Contacts))
+	for i, c := range cfg.Contacts {
+		contacts[i] = &model.Contact{
+			WaId: c.Id,
+			Profile: &model.Contact_Profile{
+				Name: c.Name,
+			},
+		}
+	}
+	return contacts
+}
+
+// Handler routes the requests of a tenant to its API. All other requests are handled by h.
+func (t *Tenants) Handler(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return t.handler(h, func(a *API) *fasthttp.Server { return a.Server })
+}
+
+// ControlHandler routes the requests of a tenant to its control endpoints. All other requests are handled by h.
+func (t *Tenants) ControlHandler(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return t.handler(h, func(a *API) *fasthttp.Server { return a.ControlServer })
+}
+
+func (t *Tenants) handler(h fasthttp.RequestHandler, server func(a *API) *fasthttp.Server) fasthttp.RequestHandler {
+	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
+		name := string(ctx.Request.Header.Peek(TenantHeader))
+
+		if path := ctx.Path(); name == "" && bytes.HasPrefix(path, []byte(TenantPathPrefix)) {
+			rest := string(path[len(TenantPathPrefix):])
+			newPath := "/"
+			if i := strings.IndexByte(rest, '/'); i >= 0 {
+				name, newPath = rest[:i], rest[i:]
+			} else {
+				name = rest
+			}
+			ctx.URI().SetPath(newPath)
+		}
+
+		if name != "" {
+			tenantAPI, ok := t.Get(name)
+			if !ok {
+				returnErrorCode(ctx, model.ErrResourceNotFound, "Could not find tenant "+name)
+				return
+			}
+			server(tenantAPI).Handler(ctx)
+			return
+		}
+
+		if tn := t.byHost(string(ctx.Host())); tn != nil {
+			server(tn.api).Handler(ctx)
+			return
+		}
+		h(ctx)
+	})
+}
+
+// CreateTenant godoc
+// @Summary Create a tenant
+// @Description Create an isolated account which is selected by host name, path prefix or the X-Tenant header.
+// @Description The users of the tenant are taken from config.users. Without users, the admin has the default password which has to be changed on the first login
+// @Tags admin
+// @Consume json
+// @Produce json
+// @Param body body model.Tenant true "the tenant"
+// @Success 201 {object} model.Tenant
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/tenants [post]
+// @Security ControlAuth
+func (a *API) CreateTenant(ctx *fasthttp.RequestCtx) {
+	if a.Tenants == nil {
+		notImplemented(ctx)
+		return
+	}
+	spec := &model.Tenant{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, spec); err != nil {
+		logger.Warn("Unable to create tenant", "error", err)
+		return
+	}
+
+	if err := a.Tenants.Create(spec); err != nil {
+		logger.Warn("Unable to create tenant", "error", err)
+		returnAPIError(ctx, err)
+		return
+	}
+	returnJSON(ctx, 201, &model.Tenant{Name: spec.Name, Hosts: spec.Hosts})
+}
+
+// ListTenants godoc
+// @Summary List all tenants
+// @Tags admin
+// @Produce json
+// @Success 200 {object} model.Tenants
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/tenants [get]
+// @Security ControlAuth
+func (a *API) ListTenants(ctx *fasthttp.RequestCtx) {
+	if a.Tenants == nil {
+		notImplemented(ctx)
+		return
+	}
+	returnJSON(ctx, 200, a.Tenants.List())
+}
+
+// DeleteTenant godoc
+// @Summary Remove a tenant
+// @Tags admin
+// @Param name path string true "Name of the tenant"
+// @Success 200
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/tenants/{name} [delete]
+// @Security ControlAuth
+func (a *API) DeleteTenant(ctx *fasthttp.RequestCtx) {
+	if a.Tenants == nil {
+		notImplemented(ctx)
+		return
+	}
+	name := ctx.UserValue("name").(string)
+	if !a.Tenants.Remove(name) {
+		returnErrorCode(ctx, model.ErrResourceNotFound, "Could not find tenant "+name)
+	}
+}
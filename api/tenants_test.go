@@ -0,0 +1,148 @@
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/util"
+)
+
+var _ = Describe("Tenants API", func() {
+	defer GinkgoRecover()
+
+	authToken, err := api.GenerateToken("admin", "ADMIN")
+	PanicIfNotNil(err)
+
+	uploadDir, err := ioutil.TempDir("", "tenant")
+	PanicIfNotNil(err)
+
+	if api.Tenants == nil {
+		PanicIfNotNil(api.EnableTenants())
+	}
+	// the token of the admin of the tenant acme
+	var tenantToken string
+
+	Context("Create a tenant", func() {
+		body := fmt.Sprintf(`{"name": "acme", "hosts": ["acme.local"], "config": {"uploadDir": "%s/"}}`, uploadDir)
+		resp, err := client.Do(NewControlRequest("POST", "/admin/tenants", bytes.NewBufferString(body)))
+		PanicIfNotNil(err)
+
+		// the tenant has its own users and tokens
+		req, _ := http.NewRequest("GET", baseUrl+"/settings/application", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		req.Header.Set("X-Tenant", "acme")
+		defaultResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		req, _ = http.NewRequest("POST", "http://localhost:8080/tenants/acme"+apiPrefix+"/users/login", bytes.NewBufferString(`{"new_password": "tenantPassword123!"}`))
+		req.SetBasicAuth("admin", "secret")
+		loginResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		login := new(model.LoginResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(loginResp.Body, login))
+		tenantToken = login.Users[0].Token
+
+		req, _ = http.NewRequest("GET", baseUrl+"/settings/application", nil)
+		req.Header.Set("Authorization", "Bearer "+login.Users[0].Token)
+		req.Header.Set("X-Tenant", "acme")
+		tenantResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		req, _ = http.NewRequest("GET", baseUrl+"/stats/app", nil)
+		req.Header.Set("Authorization", "Bearer "+login.Users[0].Token)
+		req.Header.Set("X-Tenant", "acme")
+		statsResp, err := client.Do(req)
+		PanicIfNotNil(err)
+		stats := new(model.StatsResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(statsResp.Body, stats))
+
+		It("Should create the tenant", func() {
+			Expect(resp.StatusCode).To(Equal(201))
+			Expect(loginResp.StatusCode).To(Equal(200))
+		})
+
+		It("Should isolate the tokens of the tenant", func() {
+			Expect(defaultResp.StatusCode).To(Equal(401))
+			Expect(tenantResp.StatusCode).To(Equal(200))
+		})
+
+		It("Should only report the stats of the tenant", func() {
+			Expect(statsResp.StatusCode).To(Equal(200))
+			Expect(stats.Stats.App.OutboundMessages).To(BeEmpty())
+		})
+	})
+
+	Context("Upload a webhook CA for the tenant", func() {
+		ca, _ := selfSignedCert("acme.local")
+		defaultClient := api.Webhook.Default().Client()
+		req, _ := http.NewRequest("POST", "http://localhost:8080/tenants/acme"+apiPrefix+"/certificates/webhooks/ca", bytes.NewBuffer(ca))
+		req.Header.Set("Authorization", "Bearer "+tenantToken)
+		resp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		tenantAPI, _ := api.Tenants.Get("acme")
+		tenantClient := tenantAPI.Webhook.Default().Client()
+
+		It("Should only replace the webhook client of the tenant", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(tenantClient).ToNot(BeIdenticalTo(util.DefaultClient()))
+			Expect(tenantClient.TLSConfig.RootCAs).ToNot(BeNil())
+			Expect(api.Webhook.Default().Client()).To(BeIdenticalTo(defaultClient))
+			Expect(util.DefaultClient().TLSConfig.RootCAs).To(BeNil())
+		})
+	})
+
+	Context("Create a tenant with an initial admin password", func() {
+		body := `{"name": "globex", "config": {"users": {"admin": "Initial123!"}}}`
+		resp, err := client.Do(NewControlRequest("POST", "/admin/tenants", bytes.NewBufferString(body)))
+		PanicIfNotNil(err)
+
+		req, _ := http.NewRequest("POST", "http://localhost:8080/tenants/globex"+apiPrefix+"/users/login", nil)
+		req.SetBasicAuth("admin", "Initial123!")
+		loginResp, err := client.Do(req)
+		PanicIfNotNil(err)
+		login := new(model.LoginResponse)
+		PanicIfNotNil(unmarsheler.Unmarshal(loginResp.Body, login))
+
+		req, _ = http.NewRequest("POST", "http://localhost:8080/tenants/globex"+apiPrefix+"/users/login", nil)
+		req.SetBasicAuth("admin", w_api.DefaultAdminPassword)
+		defaultLoginResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		deleteResp, err := client.Do(NewControlRequest("DELETE", "/admin/tenants/globex", nil))
+		PanicIfNotNil(err)
+
+		It("Should use the password of the request", func() {
+			Expect(resp.StatusCode).To(Equal(201))
+			Expect(loginResp.StatusCode).To(Equal(200))
+			Expect(login.Users).To(HaveLen(1))
+			Expect(defaultLoginResp.StatusCode).To(Equal(401))
+			Expect(deleteResp.StatusCode).To(Equal(200))
+		})
+	})
+
+	Context("Delete the tenant", func() {
+		resp, err := client.Do(NewControlRequest("DELETE", "/admin/tenants/acme", nil))
+		PanicIfNotNil(err)
+
+		req, _ := http.NewRequest("GET", baseUrl+"/settings/application", nil)
+		req.Header.Set("X-Tenant", "acme")
+		unknownResp, err := client.Do(req)
+		PanicIfNotNil(err)
+
+		os.RemoveAll(uploadDir)
+
+		It("Should not route to the tenant anymore", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(unknownResp.StatusCode).To(Equal(404))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	// TierWindow is the rolling window in which the unique recipients are counted
+	TierWindow = 24 * time.Hour
+
+	tierLimits = map[model.MessagingTier]int{
+		model.MessagingTier_TIER_UNLIMITED: 0,
+		model.MessagingTier_TIER_1K:        1000,
+		model.MessagingTier_TIER_10K:       10000,
+		model.MessagingTier_TIER_100K:      100000,
+	}
+)
+
+type recipientEntry struct {
+	id   string
+	sent time.Time
+}
+
+// TierLimiter counts the unique recipients of business-initiated messages in a rolling window
+// and rejects messages to new recipients once the limit of the messaging tier is reached.
+// Messages to recipients which have sent an inbound message within the window are customer-initiated and not counted.
+type TierLimiter struct {
+	tier       model.MessagingTier
+	recipients map[string]time.Time
+	order      []recipientEntry
+	sessions   map[string]time.Time
+	mux        sync.Mutex
+}
+
+func NewTierLimiter(tier model.MessagingTier) *TierLimiter {
+	return &TierLimiter{
+		tier:       tier,
+		recipients: map[string]time.Time{},
+		order:      []recipientEntry{},
+		sessions:   map[string]time.Time{},
+	}
+}
+
+func (t *TierLimiter) SetTier(tier model.MessagingTier) {
+	t.mux.Lock()
+	t.tier = tier
+	t.mux.Unlock()
+}
+
+func (t *TierLimiter) Tier() model.MessagingTier {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.tier
+}
+
+// Inbound opens the customer service window for the senders of the messages
+func (t *TierLimiter) Inbound(messages ...*model.Message) {
+	now := time.Now()
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	for _, msg := range messages {
+		if msg != nil && msg.From != "" {
+			t.sessions[msg.From] = now
+		}
+	}
+}
+
+// Allow returns whether a message may be sent to the recipient and counts it
+func (t *TierLimiter) Allow(recipient string) bool {
+	now := time.Now()
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.prune(now)
+
+	if last, ok := t.sessions[recipient]; ok {
+		if now.Sub(last) < TierWindow {
+			return true
+		}
+		delete(t.sessions, recipient)
+	}
+
+	if _, ok := t.recipients[recipient]; !ok {
+		if limit := tierLimits[t.tier]; limit > 0 && len(t.recipients) >= limit {
+			return false
+		}
+	}
+	t.recipients[recipient] = now
+	t.order = append(t.order, recipientEntry{recipient, now})
+	return true
+}
+
+// Usage returns the usage of the current window
+func (t *TierLimiter) Usage() *model.MessagingUsage {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.prune(time.Now())
+
+	limit := int64(tierLimits[t.tier])
+	usage := &model.MessagingUsage{
+		Tier:             t.tier,
+		Limit:            limit,
+		UniqueRecipients: int64(len(t.recipients)),
+		WindowSeconds:    int64(TierWindow.Seconds()),
+	}
+	if limit > 0 && limit > usage.UniqueRecipients {
+		usage.Remaining = limit - usage.UniqueRecipients
+	}
+	return usage
+}
+
+// prune removes the recipients which have not been messaged within the window
+func (t *TierLimiter) prune(now time.Time) {
+	cutoff := now.Add(-TierWindow)
+	i := 0
+	for ; i < len(t.order) && t.order[i].sent.Before(cutoff); i++ {
+		entry := t.order[i]
+		if last, ok := t.recipients[entry.id]; ok && !last.After(entry.sent) {
+			delete(t.recipients, entry.id)
+		}
+	}
+	if i > 0 {
+		t.order = append(t.order[:0], t.order[i:]...)
+	}
+}
+
+// GetMessagingUsage godoc
+// @Summary Get the usage of the messaging tier
+// @Description Get the number of unique recipients of business-initiated messages in the rolling 24 hour window
+// @Tags stats
+// @Produce json
+// @Success 200 {object} model.MessagingUsage
+// @Failure default {object} model.ErrorResponse
+// @Router /stats/messaging [get]
+// @Security BearerAuth
+func (a *API) GetMessagingUsage(ctx *fasthttp.RequestCtx) {
+	returnJSON(ctx, 200, a.Tiers.Usage())
+}
+
+// SetMessagingTier godoc
+// @Summary Set the messaging tier
+// @Description Set the tier which limits the unique recipients of business-initiated messages
+// @Tags mock
+// @Consume json
+// @Produce json
+// @Param body body model.MessagingUsage true "only the tier is used"
+// @Success 200 {object} model.MessagingUsage
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/settings/account/tier [post]
+// @Security ControlAuth
+func (a *API) SetMessagingTier(ctx *fasthttp.RequestCtx) {
+	usage := &model.MessagingUsage{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, usage); err != nil {
+		logger.Warn("Unable to set messaging tier", "error", err)
+		return
+	}
+
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		cfg.MessagingTier = usage.Tier
+		return nil
+	}); !ok {
+		return
+	}
+	a.Log.Info("Updated messaging tier", "tier", usage.Tier.String())
+	returnJSON(ctx, 200, a.Tiers.Usage())
+}
+
+// updateMessagingTier applies the messaging tier of the config to the limiter once it has been changed
+func (a *API) updateMessagingTier(previous, current *ConfigSnapshot) {
+	if previous.MessagingTier != current.MessagingTier {
+		a.Tiers.SetTier(current.MessagingTier)
+	}
+}
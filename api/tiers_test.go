@@ -0,0 +1,43 @@
+package api_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+var _ = Describe("Messaging tiers", func() {
+
+	Context("Tier 1K", func() {
+		limiter := w_api.NewTierLimiter(model.MessagingTier_TIER_1K)
+		rejected := 0
+		for i := 0; i < 1000; i++ {
+			if !limiter.Allow(fmt.Sprintf("49170%07d", i)) {
+				rejected++
+			}
+		}
+
+		It("Should allow new recipients up to the limit", func() {
+			Expect(rejected).To(Equal(0))
+		})
+
+		It("Should reject new recipients once the limit is reached", func() {
+			Expect(limiter.Allow("491709999999")).To(BeFalse())
+			Expect(limiter.Usage().UniqueRecipients).To(Equal(int64(1000)))
+			Expect(limiter.Usage().Remaining).To(Equal(int64(0)))
+		})
+
+		It("Should allow known recipients", func() {
+			Expect(limiter.Allow("491700000001")).To(BeTrue())
+		})
+
+		It("Should allow customer-initiated conversations", func() {
+			limiter.Inbound(&model.Message{From: "491708888888"})
+			Expect(limiter.Allow("491708888888")).To(BeTrue())
+			Expect(limiter.Usage().UniqueRecipients).To(Equal(int64(1000)))
+		})
+	})
+})
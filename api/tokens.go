@@ -0,0 +1,345 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go/v4"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+	"github.com/ron96G/whatsapp-bizapi-mock/util"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// SigningKeyStateKey is the key of the generated signing key
+	SigningKeyStateKey = "signing_key"
+	// SessionsStateKey is the key of the hashes and claims of the active tokens
+	SessionsStateKey = "sessions"
+
+	tokenIssuer = "WhatsAppMockserver"
+)
+
+var (
+	// TokenValidDuration is the lifetime of new tokens
+	TokenValidDuration = 7 * 24 * time.Hour
+	// SigningKey signs the tokens. If it is empty, a random key is generated and kept in the state store
+	SigningKey []byte
+	// TokenSweepInterval is the interval in which expired tokens are removed
+	TokenSweepInterval = time.Minute
+)
+
+// TokenStore issues the bearer tokens of the users. The tokens are JWTs which are only valid
+// as long as they are contained in the store, so that they can be revoked before they expire.
+// Only the SHA-256 hashes of the tokens are kept, so that the persisted state cannot be replayed.
+type TokenStore struct {
+	TTL       time.Duration
+	Store     store.Store
+	key       []byte
+	generated bool
+	sessions  map[string]*model.Session // by the hash of the token
+	mux       sync.Mutex
+}
+
+// NewTokenStore creates a token store which signs the tokens with the key.
+// Without a key, a random key is generated.
+func NewTokenStore(key []byte, ttl time.Duration) *TokenStore {
+	s := &TokenStore{
+		TTL:      ttl,
+		Store:    store.NewMemoryStore(),
+		key:      key,
+		sessions: map[string]*model.Session{},
+	}
+	if len(key) == 0 {
+		s.key = make([]byte, 32)
+		if _, err := rand.Read(s.key); err != nil {
+			panic(err)
+		}
+		s.generated = true
+	}
+	return s
+}
+
+// UseStore persists the sessions in the store from now on. The sessions of the store are restored.
+// A generated signing key is kept in the store, so that the tokens survive a restart.
+// The tokens of older states are migrated to sessions.
+func (s *TokenStore) UseStore(st store.Store) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.generated {
+		stored := &model.SigningKey{}
+		found, err := st.Get(SigningKeyStateKey, stored)
+		if err != nil {
+			return err
+		}
+		if found && len(stored.Key) > 0 {
+			s.key = stored.Key
+		} else if err = st.Put(SigningKeyStateKey, &model.SigningKey{Key: s.key}); err != nil {
+			return err
+		}
+	}
+
+	sessions := &model.SessionList{}
+	if _, err := st.Get(SessionsStateKey, sessions); err != nil {
+		return err
+	}
+	for _, session := range sessions.Sessions {
+		s.sessions[session.Hash] = session
+	}
+
+	tokens := &model.TokenList{}
+	found, err := st.Get(TokensStateKey, tokens)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens.Tokens {
+		if claims, err := s.parse(token); err == nil {
+			s.sessions[tokenHash(token)] = newSession(tokenHash(token), claims)
+		}
+	}
+
+	s.Store = st
+	if err = s.save(); err != nil {
+		return err
+	}
+	if found {
+		return st.Delete(TokensStateKey)
+	}
+	return nil
+}
+
+func (s *TokenStore) parse(token string) (*CustomClaims, error) {
+	claims := &CustomClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return s.key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func tokenHash(token string) string {
+	return hex.EncodeToString(util.Sha256Hash([]byte(token)))
+}
+
+func newSession(hash string, claims *CustomClaims) *model.Session {
+	return &model.Session{
+		Id:        hash[:16],
+		User:      claims.Subject,
+		Role:      claims.Role,
+		IssuedAt:  unixTime(claims.IssuedAt),
+		ExpiresAt: unixTime(claims.ExpiresAt),
+		Hash:      hash,
+	}
+}
+
+// Issue creates a new token for the user
+func (s *TokenStore) Issue(user, role string) (string, *CustomClaims, error) {
+	// https://self-issued.info/docs/draft-ietf-oauth-json-web-token.html#rfc.section.4.1.7
+	now := time.Now()
+	claims := &CustomClaims{
+		Role: role,
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    tokenIssuer,
+			Subject:   user,
+			ExpiresAt: jwt.At(time.Unix(now.Add(s.TTL).Unix(), 0)),
+			IssuedAt:  jwt.At(time.Unix(now.Unix(), 0)),
+		},
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.key)
+	if err != nil {
+		return "", nil, err
+	}
+	s.sweep(now)
+	hash := tokenHash(token)
+	s.sessions[hash] = newSession(hash, claims)
+	if err = s.save(); err != nil {
+		delete(s.sessions, hash)
+		return "", nil, err
+	}
+	return token, claims, nil
+}
+
+// Validate returns the claims of the token if it is active
+func (s *TokenStore) Validate(token string) (*CustomClaims, error) {
+	s.mux.Lock()
+	_, active := s.sessions[tokenHash(token)]
+	s.mux.Unlock()
+	if !active {
+		return nil, fmt.Errorf("token is not active")
+	}
+	return s.parse(token)
+}
+
+// Revoke removes the token
+func (s *TokenStore) Revoke(token string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.sessions, tokenHash(token))
+	return s.save()
+}
+
+// RevokeUser removes all tokens of the user and returns their number
+func (s *TokenStore) RevokeUser(user string) (int, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	revoked := 0
+	for hash, session := range s.sessions {
+		if session.User == user {
+			delete(s.sessions, hash)
+			revoked++
+		}
+	}
+	if revoked == 0 {
+		return 0, nil
+	}
+	return revoked, s.save()
+}
+
+// Sweep removes the expired tokens
+func (s *TokenStore) Sweep() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.sweep(time.Now()) == 0 {
+		return nil
+	}
+	return s.save()
+}
+
+// sweep removes the tokens which have expired at now. The caller must hold the lock.
+func (s *TokenStore) sweep(now time.Time) int {
+	swept := 0
+	for hash, session := range s.sessions {
+		if session.ExpiresAt != 0 && now.Unix() >= session.ExpiresAt {
+			delete(s.sessions, hash)
+			swept++
+		}
+	}
+	return swept
+}
+
+// Sessions describes the active tokens ordered by their issue time
+func (s *TokenStore) Sessions() *model.SessionList {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	list := &model.SessionList{}
+	for _, session := range s.sessions {
+		described := *session
+		described.Hash = ""
+		list.Sessions = append(list.Sessions, &described)
+	}
+	sort.Slice(list.Sessions, func(i, j int) bool {
+		if list.Sessions[i].IssuedAt != list.Sessions[j].IssuedAt {
+			return list.Sessions[i].IssuedAt < list.Sessions[j].IssuedAt
+		}
+		return list.Sessions[i].Id < list.Sessions[j].Id
+	})
+	return list
+}
+
+func unixTime(t *jwt.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+func (s *TokenStore) Len() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return len(s.sessions)
+}
+
+// Save writes the active sessions to the store
+func (s *TokenStore) Save() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.save()
+}
+
+func (s *TokenStore) save() error {
+	sessions := &model.SessionList{}
+	for _, session := range s.sessions {
+		sessions.Sessions = append(sessions.Sessions, session)
+	}
+	sort.Slice(sessions.Sessions, func(i, j int) bool {
+		return sessions.Sessions[i].Hash < sessions.Sessions[j].Hash
+	})
+	return s.Store.Put(SessionsStateKey, sessions)
+}
+
+// RunTokenSweeper removes the expired tokens of the API and its tenants in the interval.
+// The returned function stops the sweeper.
+func (a *API) RunTokenSweeper(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				a.sweepTokens()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (a *API) sweepTokens() {
+	if err := a.Tokens.Sweep(); err != nil {
+		a.Log.Error("Unable to sweep expired tokens", "error", err)
+	}
+	if a.Tenants != nil {
+		for _, api := range a.Tenants.apis() {
+			api.sweepTokens()
+		}
+	}
+}
+
+// GenerateToken issues a new token for the user
+func (a *API) GenerateToken(user string, role string) (string, error) {
+	token, _, err := a.Tokens.Issue(user, role)
+	return token, err
+}
+
+// revokeTokens revokes all tokens of the user. Errors are logged as the change of the user has already been applied.
+func (a *API) revokeTokens(ctx *fasthttp.RequestCtx, user string) {
+	revoked, err := a.Tokens.RevokeUser(user)
+	if err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to persist tokens", "error", err)
+		return
+	}
+	if revoked > 0 {
+		a.LoggerFromCtx(ctx).Info("Revoked tokens", "user", user, "tokens", revoked)
+	}
+}
+
+// ListSessions godoc
+// @Summary List the active sessions
+// @Description Returns the user, role, issue and expiry time of all active tokens
+// @Tags mock
+// @Produce json
+// @Success 200 {object} model.SessionList
+// @Failure default {object} model.ErrorResponse
+// @Router /mock/admin/sessions [get]
+// @Security ControlAuth
+func (a *API) ListSessions(ctx *fasthttp.RequestCtx) {
+	if err := a.Tokens.Sweep(); err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to sweep expired tokens", "error", err)
+	}
+	returnJSON(ctx, 200, a.Tokens.Sessions())
+}
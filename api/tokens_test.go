@@ -0,0 +1,109 @@
+package api_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	w_api "github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+)
+
+var _ = Describe("Token Store", func() {
+	defer GinkgoRecover()
+
+	Context("Restart with the same state", func() {
+		st := store.NewMemoryStore()
+		tokens := w_api.NewTokenStore(nil, time.Hour)
+		PanicIfNotNil(tokens.UseStore(st))
+		token, _, err := tokens.Issue("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+
+		restarted := w_api.NewTokenStore(nil, time.Hour)
+		PanicIfNotNil(restarted.UseStore(st))
+		claims, validateErr := restarted.Validate(token)
+
+		It("Should keep the tokens and the generated signing key", func() {
+			Expect(validateErr).To(BeNil())
+			Expect(claims.Subject).To(Equal("admin"))
+		})
+	})
+
+	Context("Persist only the hashes of the tokens", func() {
+		st := store.NewMemoryStore()
+		tokens := w_api.NewTokenStore([]byte("key"), time.Hour)
+		PanicIfNotNil(tokens.UseStore(st))
+		token, _, err := tokens.Issue("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		stored := &model.SessionList{}
+		_, err = st.Get(w_api.SessionsStateKey, stored)
+		PanicIfNotNil(err)
+
+		forged := w_api.NewTokenStore([]byte("key"), time.Hour)
+		forgedToken, _, err := forged.Issue("operator", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		_, forgedErr := tokens.Validate(forgedToken)
+
+		It("Should not contain the token and reject tokens which were not issued by the store", func() {
+			Expect(stored.Sessions).To(HaveLen(1))
+			Expect(stored.Sessions[0].Hash).ToNot(BeEmpty())
+			Expect(stored.Sessions[0].Hash).ToNot(Equal(token))
+			Expect(stored.String()).ToNot(ContainSubstring(token))
+			Expect(forgedErr).ToNot(BeNil())
+		})
+	})
+
+	Context("Migrate the tokens of an older state", func() {
+		st := store.NewMemoryStore()
+		legacy := w_api.NewTokenStore([]byte("key"), time.Hour)
+		token, _, err := legacy.Issue("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		PanicIfNotNil(st.Put(w_api.TokensStateKey, &model.TokenList{Tokens: []string{token}}))
+
+		tokens := w_api.NewTokenStore([]byte("key"), time.Hour)
+		PanicIfNotNil(tokens.UseStore(st))
+		claims, validateErr := tokens.Validate(token)
+		found, err := st.Get(w_api.TokensStateKey, &model.TokenList{})
+		PanicIfNotNil(err)
+
+		It("Should keep the tokens valid and remove them from the state", func() {
+			Expect(validateErr).To(BeNil())
+			Expect(claims.Subject).To(Equal("admin"))
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("Sweep expired tokens", func() {
+		tokens := w_api.NewTokenStore([]byte("key"), -time.Second)
+		token, _, err := tokens.Issue("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		_, validateErr := tokens.Validate(token)
+		PanicIfNotNil(tokens.Sweep())
+		remaining := tokens.Len()
+
+		It("Should reject and remove the tokens", func() {
+			Expect(validateErr).ToNot(BeNil())
+			Expect(remaining).To(Equal(0))
+		})
+	})
+
+	Context("List the active sessions", func() {
+		_, err := api.GenerateToken("admin", w_api.RoleAdmin)
+		PanicIfNotNil(err)
+		resp, err := client.Do(NewControlRequest("GET", "/admin/sessions", nil))
+		PanicIfNotNil(err)
+		sessions := &model.SessionList{}
+		PanicIfNotNil(unmarsheler.Unmarshal(resp.Body, sessions))
+		users := map[string]int64{}
+		for _, session := range sessions.Sessions {
+			users[session.User] = session.ExpiresAt
+		}
+
+		It("Should contain the session of the user", func() {
+			Expect(resp.StatusCode).To(Equal(200))
+			Expect(users).To(HaveKey("admin"))
+			Expect(users["admin"]).To(BeNumerically(">", time.Now().Unix()))
+		})
+	})
+})
@@ -3,12 +3,127 @@ package api
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
 	"github.com/valyala/fasthttp"
+	"golang.org/x/crypto/bcrypt"
 )
 
+var (
+	// DefaultAdminPassword is the initial password of the admin. It has to be changed on the first login
+	DefaultAdminPassword = "secret"
+	// PasswordCost is the bcrypt cost of the password and two-step PIN hashes
+	PasswordCost = bcrypt.DefaultCost
+	// MinPasswordLength and MaxPasswordLength limit the length of new passwords
+	MinPasswordLength = 8
+	MaxPasswordLength = 64
+)
+
+var (
+	dummyHash     []byte
+	dummyHashOnce sync.Once
+)
+
+// dummyPasswordHash returns the hash which is compared with the password of unknown users
+func dummyPasswordHash() []byte {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password of an unknown user"), PasswordCost)
+	})
+	return dummyHash
+}
+
+// CheckPassword enforces the password policy of the WhatsApp Business API.
+// Passwords have 8 to 64 characters and contain at least one upper case letter,
+// one lower case letter, one digit and one special character.
+func CheckPassword(password string) error {
+	if n := len([]rune(password)); n < MinPasswordLength || n > MaxPasswordLength {
+		return model.ErrParameterValueInvalid.Err(fmt.Sprintf("The password must have between %d and %d characters", MinPasswordLength, MaxPasswordLength))
+	}
+	var upper, lower, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsSpace(r):
+			return model.ErrParameterValueInvalid.Err("The password must not contain whitespace")
+		default:
+			special = true
+		}
+	}
+	if !upper || !lower || !digit || !special {
+		return model.ErrParameterValueInvalid.Err("The password must contain an upper case letter, a lower case letter, a digit and a special character")
+	}
+	return nil
+}
+
+// newUserRecord hashes the password of a user
+func newUserRecord(password string, role model.User_Role) (*model.UserRecord, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), PasswordCost)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	return &model.UserRecord{
+		PasswordHash:    hash,
+		Role:            role,
+		Created:         now,
+		PasswordChanged: now,
+	}, nil
+}
+
+// MigrateUsers replaces the plaintext passwords of the config by user records.
+// Plaintext users only create users which do not exist yet. Users with a record in the config or in current
+// keep it, so that a reload does not reset a changed password. The user admin becomes ADMIN, all other users USER.
+// The default password of the admin has to be changed on the next login.
+func MigrateUsers(cfg *model.InternalConfig, current map[string]*model.UserRecord) error {
+	if len(cfg.Users) == 0 {
+		return nil
+	}
+	if cfg.UserRecords == nil {
+		cfg.UserRecords = map[string]*model.UserRecord{}
+	}
+	for name, password := range cfg.Users {
+		if _, ok := cfg.UserRecords[name]; ok {
+			continue
+		}
+		if record, ok := current[name]; ok {
+			cfg.UserRecords[name] = record
+			continue
+		}
+		role := model.User_USER
+		if name == "admin" {
+			role = model.User_ADMIN
+		}
+		record, err := newUserRecord(password, role)
+		if err != nil {
+			return fmt.Errorf("unable to migrate user %s: %v", name, err)
+		}
+		record.PasswordChangeRequired = password == DefaultAdminPassword
+		cfg.UserRecords[name] = record
+	}
+	cfg.Users = nil
+	return nil
+}
+
+func userInfo(name string, record *model.UserRecord) *model.UserInfo {
+	return &model.UserInfo{
+		Username:               name,
+		Role:                   record.Role,
+		PasswordChangeRequired: record.PasswordChangeRequired,
+		Created:                record.Created,
+		PasswordChanged:        record.PasswordChanged,
+	}
+}
+
 // Login godoc
 // @Summary Login into the application
 // @Description Login into the application using basic auth
@@ -21,54 +136,66 @@ import (
 func (a *API) Login(ctx *fasthttp.RequestCtx) {
 	username, password, err := basicAuth(ctx)
 	if err != nil {
-		returnError(ctx, 401, model.Error{
-			Code:    401,
-			Details: "Missing Authorization",
-			Title:   "Client Error",
-		})
-		return
-	}
-	if pwd, ok := a.Config.Users[username]; ok {
-		if pwd == password { // check if entered password is correct
-			if pwd == "secret" { // check if the password has been changed, if not, it must be done now
-				chPwdReq := new(model.ChangePwdRequest)
-				err := unmarsheler.Unmarshal(bytes.NewReader(ctx.PostBody()), chPwdReq)
-				if err != nil || chPwdReq.NewPassword == "" { // check if the request contained a new password
-					returnError(ctx, 400, model.Error{
-						Code:    400,
-						Details: "Password change required",
-						Title:   "Client Error",
-						Href:    "",
-					})
-					return
-				}
-				a.Config.Users[username] = chPwdReq.NewPassword // change the password
-			}
+		returnError(ctx, 401, model.ErrAccessDenied.New("Missing Authorization"))
+		return
+	}
+	ip := ctx.RemoteIP().String()
+	if userRemaining, ipRemaining := a.Logins.Check(username, ip); userRemaining > 0 || ipRemaining > 0 {
+		a.LoggerFromCtx(ctx).Warn("Rejected login", "reason", "locked", "user", username, "ip", ip)
+		returnLockedOut(ctx, userRemaining, ipRemaining)
+		return
+	}
+	record, ok := a.Config.Current().UserRecords[username]
+	hash := dummyPasswordHash()
+	if ok {
+		hash = record.PasswordHash
+	}
+	// unknown users are compared as well, so that the response time does not reveal which users exist
+	if bcrypt.CompareHashAndPassword(hash, []byte(password)) != nil || !ok {
+		a.Logins.Fail(username, ip)
+		returnError(ctx, 401, model.ErrAccessDenied.New("Username or password is invalid"))
+		return
+	}
+	a.Logins.Succeed(username)
 
-			role := "USER"
-			if username == "admin" {
-				role = "ADMIN"
-			}
-			// generate new token for the user
-			newToken, err := a.GenerateToken(username, role)
-			if err != nil {
-				returnError(ctx, 500, model.Error{
-					Code:    500,
-					Details: err.Error(),
-					Title:   "Server Error",
-				})
+	if record.PasswordChangeRequired { // the password has to be changed on this login
+		chPwdReq := new(model.ChangePwdRequest)
+		err := unmarsheler.Unmarshal(bytes.NewReader(ctx.PostBody()), chPwdReq)
+		if err != nil || chPwdReq.NewPassword == "" { // check if the request contained a new password
+			returnError(ctx, 400, model.ErrAccessDenied.New("Password change required"))
+			return
+		}
+		if err = CheckPassword(chPwdReq.NewPassword); err != nil {
+			returnAPIError(ctx, err)
+			return
+		}
+		changed, err := newUserRecord(chPwdReq.NewPassword, record.Role)
+		if err != nil {
+			returnErrorCode(ctx, model.ErrInternal, err.Error())
+			return
+		}
+		if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+			current, ok := cfg.UserRecords[username]
+			if !ok || !bytes.Equal(current.PasswordHash, record.PasswordHash) { // the password has been changed concurrently
+				return model.ErrAccessDenied.Err("Username or password is invalid")
 			}
-			returnToken(ctx, newToken)
+			current.PasswordHash = changed.PasswordHash
+			current.PasswordChanged = changed.PasswordChanged
+			current.PasswordChangeRequired = false
+			return nil
+		}); !ok {
 			return
 		}
+		a.revokeTokens(ctx, username)
 	}
 
-	returnError(ctx, 401, model.Error{
-		Code:    401,
-		Details: "Username or password is invalid",
-		Title:   "Client Error",
-		Href:    "",
-	})
+	// generate new token for the user
+	newToken, claims, err := a.Tokens.Issue(username, record.Role.String())
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+	returnToken(ctx, newToken, claims)
 }
 
 // Logout godoc
@@ -84,16 +211,19 @@ func (a *API) Logout(ctx *fasthttp.RequestCtx) {
 	auth := string(ctx.Request.Header.Peek("Authorization"))
 	token := strings.TrimPrefix(auth, "Bearer ")
 	token = strings.TrimSpace(token)
-	a.Tokens.Del(token)
+	if err := a.Tokens.Revoke(token); err != nil {
+		a.LoggerFromCtx(ctx).Error("Unable to persist tokens", "error", err)
+		returnErrorCode(ctx, model.ErrInternal, "Unable to persist the state")
+	}
 }
 
 // CreateUser godoc
 // @Summary create a new user in the application
-// @Description An admin may use this endpoint to create a new user
+// @Description An admin may use this endpoint to create a new user. Without a role, the user is created as USER
 // @Tags users
 // @Consume json
 // @Produce json
-// @Param body body model.User true "username and password of the user"
+// @Param body body model.User true "username, password and role of the user"
 // @Success 200 {object} model.LoginResponse
 // @Failure default {object} model.ErrorResponse
 // @Router /users [post]
@@ -105,6 +235,19 @@ func (a *API) CreateUser(ctx *fasthttp.RequestCtx) {
 		logger.Warn("Unable to create user", "error", err)
 		return
 	}
+	if err := CheckPassword(user.Password); err != nil {
+		returnAPIError(ctx, err)
+		return
+	}
+	if user.Role == model.User_UNSPECIFIED {
+		user.Role = model.User_USER
+	}
+	record, err := newUserRecord(user.Password, user.Role)
+	if err != nil {
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
+		return
+	}
+
 	response := AcquireLoginResponse()
 	response.Reset()
 	defer ReleaseLoginResponse(response)
@@ -112,18 +255,136 @@ func (a *API) CreateUser(ctx *fasthttp.RequestCtx) {
 	response.Meta = AcquireMeta()
 	defer ReleaseMeta(response.Meta)
 
-	if _, exists := a.Config.Users[user.Username]; exists {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Title:   "User already exists",
-			Details: fmt.Sprintf("The requested user %s already exists", user.Username),
-		})
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		if _, exists := cfg.UserRecords[user.Username]; exists {
+			return model.ErrResourceAlreadyExists.Err(fmt.Sprintf("The requested user %s already exists", user.Username))
+		}
+		if cfg.UserRecords == nil {
+			cfg.UserRecords = map[string]*model.UserRecord{}
+		}
+		cfg.UserRecords[user.Username] = record
+		return nil
+	}); !ok {
 		return
 	}
-	a.Config.Users[user.Username] = user.Password
 	returnJSON(ctx, 201, response)
 }
 
+// ListUsers godoc
+// @Summary list the users of the application
+// @Tags users
+// @Produce json
+// @Success 200 {object} model.UserList
+// @Failure default {object} model.ErrorResponse
+// @Router /users [get]
+// @Security BearerAuth
+func (a *API) ListUsers(ctx *fasthttp.RequestCtx) {
+	list := &model.UserList{}
+	for name, record := range a.Config.Current().UserRecords {
+		list.Users = append(list.Users, userInfo(name, record))
+	}
+	sort.Slice(list.Users, func(i, j int) bool { return list.Users[i].Username < list.Users[j].Username })
+	returnJSON(ctx, 200, list)
+}
+
+// GetUser godoc
+// @Summary get a user of the application
+// @Description An admin may get every user, all other users only themselves
+// @Tags users
+// @Param username path string true "Name of the user"
+// @Produce json
+// @Success 200 {object} model.UserInfo
+// @Failure default {object} model.ErrorResponse
+// @Router /users/{username} [get]
+// @Security BearerAuth
+func (a *API) GetUser(ctx *fasthttp.RequestCtx) {
+	name := ctx.UserValue("name").(string)
+	if claims := claimsFromCtx(ctx); claims.Role != model.User_ADMIN.String() && claims.Subject != name {
+		returnErrorCode(ctx, model.ErrAccessDenied, "Only admins may get other users")
+		return
+	}
+
+	record, ok := a.Config.Current().UserRecords[name]
+	if !ok {
+		returnErrorCode(ctx, model.ErrResourceNotFound, fmt.Sprintf("Could not find user with name %s", name))
+		return
+	}
+	returnJSON(ctx, 200, userInfo(name, record))
+}
+
+// UpdateUser godoc
+// @Summary change the password or the role of a user
+// @Description An admin may update every user, all other users only their own password
+// @Tags users
+// @Param username path string true "Name of the user"
+// @Consume json
+// @Produce json
+// @Param body body model.UpdateUserRequest true "the new password and/or role"
+// @Success 200 {object} model.UserInfo
+// @Failure default {object} model.ErrorResponse
+// @Router /users/{username} [put]
+// @Security BearerAuth
+func (a *API) UpdateUser(ctx *fasthttp.RequestCtx) {
+	name := ctx.UserValue("name").(string)
+	req := &model.UpdateUserRequest{}
+	logger := a.LoggerFromCtx(ctx)
+	if err := unmarshalPayload(ctx, req); err != nil {
+		logger.Warn("Unable to update user", "error", err)
+		return
+	}
+
+	isAdmin := claimsFromCtx(ctx).Role == model.User_ADMIN.String()
+	switch {
+	case !isAdmin && claimsFromCtx(ctx).Subject != name:
+		returnErrorCode(ctx, model.ErrAccessDenied, "Only admins may update other users")
+		return
+	case !isAdmin && req.Role != model.User_UNSPECIFIED:
+		returnErrorCode(ctx, model.ErrAccessDenied, "Only admins may change the role of a user")
+		return
+	case name == "admin" && req.Role != model.User_UNSPECIFIED && req.Role != model.User_ADMIN:
+		returnErrorCode(ctx, model.ErrAccessDenied, fmt.Sprintf("The role of the user %s cannot be changed", name))
+		return
+	case req.Password == "" && req.Role == model.User_UNSPECIFIED:
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, "Either password or role is required")
+		return
+	}
+
+	var changed *model.UserRecord
+	if req.Password != "" {
+		if err := CheckPassword(req.Password); err != nil {
+			returnAPIError(ctx, err)
+			return
+		}
+		var err error
+		if changed, err = newUserRecord(req.Password, req.Role); err != nil {
+			returnErrorCode(ctx, model.ErrInternal, err.Error())
+			return
+		}
+	}
+
+	snapshot, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		record, ok := cfg.UserRecords[name]
+		if !ok {
+			return model.ErrResourceNotFound.Err(fmt.Sprintf("Could not find user with name %s", name))
+		}
+		if changed != nil {
+			record.PasswordHash = changed.PasswordHash
+			record.PasswordChanged = changed.PasswordChanged
+			record.PasswordChangeRequired = false
+		}
+		if req.Role != model.User_UNSPECIFIED {
+			record.Role = req.Role
+		}
+		return nil
+	})
+	if !ok {
+		return
+	}
+	// the tokens contain the role and have been issued for the old password
+	a.revokeTokens(ctx, name)
+	returnJSON(ctx, 200, userInfo(name, snapshot.UserRecords[name]))
+}
+
 // DeleteUser godoc
 // @Summary delete an existing user in the application
 // @Description An admin may use this endpoint to delete an existing user
@@ -138,24 +399,17 @@ func (a *API) DeleteUser(ctx *fasthttp.RequestCtx) {
 	name := ctx.UserValue("name").(string)
 
 	if name == "admin" {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: fmt.Sprintf("The user %s cannot be deleted", name),
-			Title:   "Client Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrAccessDenied, fmt.Sprintf("The user %s cannot be deleted", name))
 		return
 	}
 
-	if _, ok := a.Config.Users[name]; ok {
-		delete(a.Config.Users, name)
-	} else {
-		returnError(ctx, 404, model.Error{
-			Code:    404,
-			Details: fmt.Sprintf("Could not find user with name %s", name),
-			Title:   "Client Error",
-			Href:    "",
-		})
-		return
+	if _, ok := a.updateConfig(ctx, func(cfg *model.InternalConfig) error {
+		if _, ok := cfg.UserRecords[name]; !ok {
+			return model.ErrResourceNotFound.Err(fmt.Sprintf("Could not find user with name %s", name))
+		}
+		delete(cfg.UserRecords, name)
+		return nil
+	}); ok {
+		a.revokeTokens(ctx, name)
 	}
 }
@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
@@ -12,7 +13,6 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"time"
 
 	jwt "github.com/dgrijalva/jwt-go/v4"
 	"github.com/gogo/protobuf/jsonpb"
@@ -37,9 +37,6 @@ var (
 		AllowUnknownFields: true,
 	}
 
-	TokenValidDuration = 7 * 24 * time.Hour
-	SigningKey         = []byte("e555f49db14afa8244ab4ccf630bd0020144b124217dd56781b00a6e024cb836")
-
 	TimeFormatTokenExpiration = "2006-01-02 15:04:05+00:00"
 )
 
@@ -64,34 +61,6 @@ func extractAuthToken(ctx *fasthttp.RequestCtx, key string) (val string, ok bool
 	return auth, auth != ""
 }
 
-func parseToken(ctx *fasthttp.RequestCtx) (*jwt.Token, error) {
-	tokenString, ok := extractAuthToken(ctx, "Bearer ")
-	if !ok {
-		return nil, fmt.Errorf("unable to find bearer token in request")
-	}
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return SigningKey, nil
-	})
-	return token, err
-}
-
-func parseTokenWithClaims(ctx *fasthttp.RequestCtx) (*jwt.Token, error) {
-	tokenString, ok := extractAuthToken(ctx, "Bearer ")
-	if !ok {
-		return nil, fmt.Errorf("unable to find bearer token in request")
-	}
-	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return SigningKey, nil
-	})
-	return token, err
-}
-
 func contains(slice []string, item string) bool {
 	for _, element := range slice {
 		if element == item {
@@ -108,7 +77,10 @@ func basicAuth(ctx *fasthttp.RequestCtx) (string, string, error) {
 		if err != nil {
 			return "", "", err
 		}
-		splittedAuth := strings.Split(string(auth), ":")
+		splittedAuth := strings.SplitN(string(auth), ":", 2)
+		if len(splittedAuth) != 2 {
+			return "", "", fmt.Errorf("invalid basic auth credentials")
+		}
 		return splittedAuth[0], splittedAuth[1], nil
 	} else {
 		return "", "", fmt.Errorf("unable to find Authorization header")
@@ -121,36 +93,53 @@ func returnError(ctx *fasthttp.RequestCtx, statusCode int, errors ...model.Error
 	defer ReleaseErrorResponse(response)
 
 	response.Meta = AcquireMeta()
-	for _, err := range errors {
-		response.Errors = append(response.Errors, &err)
+	for i := range errors {
+		response.Errors = append(response.Errors, &errors[i])
 	}
 	returnJSON(ctx, statusCode, response)
 }
 
+// returnErrorCode responds with the error of the catalogue and its status code
+func returnErrorCode(ctx *fasthttp.RequestCtx, code model.ErrorCode, details string) {
+	returnError(ctx, code.StatusCode, code.New(details))
+}
+
+// returnAPIError responds with the catalogue error of err or an internal error
+func returnAPIError(ctx *fasthttp.RequestCtx, err error) {
+	if apiErr, ok := err.(*model.APIError); ok {
+		returnErrorCode(ctx, apiErr.Code, apiErr.Details)
+		return
+	}
+	returnErrorCode(ctx, model.ErrInternal, err.Error())
+}
+
 func unmarshalPayload(ctx *fasthttp.RequestCtx, msg Message) error {
 	err := unmarsheler.Unmarshal(bytes.NewReader(ctx.PostBody()), msg)
 	if err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: err.Error(),
-			Title:   "Unable to unmarshal payload",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrGenericUser, err.Error())
 		return fmt.Errorf("unmarshal payload: %v", err)
 	}
 	err = validatePayload(ctx, msg)
 	if err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: err.Error(),
-			Title:   "Validation of input failed",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
 		return err
 	}
 	return nil
 }
 
+// presentFields returns the names of the top-level fields of the JSON payload
+func presentFields(payload []byte) map[string]bool {
+	raw := map[string]json.RawMessage{}
+	fields := map[string]bool{}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return fields
+	}
+	for name := range raw {
+		fields[name] = true
+	}
+	return fields
+}
+
 func validatePayload(ctx *fasthttp.RequestCtx, msg Message) error {
 	if err := msg.Validate(); err != nil {
 		return fmt.Errorf("validate payload: %v", err)
@@ -164,43 +153,20 @@ func returnJSON(ctx *fasthttp.RequestCtx, statusCode int, out proto.Message) {
 	marsheler.Marshal(ctx, out)
 }
 
+// notImplemented responds like the WhatsApp API to an unknown resource
 func notImplemented(ctx *fasthttp.RequestCtx) {
-	returnError(ctx, 501, model.Error{
-		Code:    501,
-		Details: fmt.Sprintf("The resource %s is not implemented yet", ctx.Path()),
-		Title:   "Not Implemented",
-		Href:    "",
-	})
+	returnErrorCode(ctx, model.ErrResourceNotFound, fmt.Sprintf("The resource %s is not implemented yet", ctx.Path()))
 }
 
 func NotImplementedHandler(ctx *fasthttp.RequestCtx) {
 	notImplemented(ctx)
 }
 
-func (a *API) GenerateToken(user string, role string) (string, error) {
-
-	// https://self-issued.info/docs/draft-ietf-oauth-json-web-token.html#rfc.section.4.1.7
-	now := time.Now()
-	atClaims := jwt.MapClaims{}
-	atClaims["iss"] = "WhatsAppMockserver"
-	atClaims["sub"] = user
-	atClaims["exp"] = now.Add(TokenValidDuration).Unix()
-	atClaims["iat"] = now.Unix()
-	atClaims["role"] = role
-	at := jwt.NewWithClaims(jwt.SigningMethodHS256, atClaims)
-	token, err := at.SignedString(SigningKey)
-	if err != nil {
-		return "", err
-	}
-	a.Tokens.Add(token)
-	return token, nil
-}
-
-func returnToken(ctx *fasthttp.RequestCtx, token string) {
+func returnToken(ctx *fasthttp.RequestCtx, token string, claims *CustomClaims) {
 	response := AcquireLoginResponse()
 	defer ReleaseLoginResponse(response)
 	response.Reset()
-	expires := time.Now().Add(TokenValidDuration).Format(TimeFormatTokenExpiration)
+	expires := claims.ExpiresAt.UTC().Format(TimeFormatTokenExpiration)
 	response.Users = append(response.Users,
 		&model.TokenResponse{
 			Token:        token,
@@ -214,20 +180,12 @@ func getQueryArgInt(ctx *fasthttp.RequestCtx, key string) (n int, ok bool) {
 	var err error
 	queryArg := string(ctx.QueryArgs().Peek(key))
 	if len(queryArg) == 0 {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: "Unable to parse query argument",
-			Title:   "Client Error",
-		})
+		returnErrorCode(ctx, model.ErrRequiredParameterMissing, fmt.Sprintf("Missing query argument %s", key))
 		return 0, false
 	}
 
 	if n, err = strconv.Atoi(queryArg); err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: "Unable to parse query argument",
-			Title:   "Client Error",
-		})
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, fmt.Sprintf("Unable to parse query argument %s", key))
 		return 0, false
 	}
 	return n, true
@@ -255,23 +213,13 @@ func generateRandomCode(n int) (numbers string) {
 func savePostBody(ctx *fasthttp.RequestCtx, fpath string) (ok bool) {
 	_, err := mime.ExtensionsByType(string(ctx.Request.Header.ContentType()))
 	if err != nil {
-		returnError(ctx, 400, model.Error{
-			Code:    400,
-			Details: err.Error(),
-			Title:   "Client Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrParameterValueInvalid, err.Error())
 		return false
 	}
 	filePath := filepath.Join(filepath.Clean(fpath))
 	f, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE, 0600)
 	if err != nil {
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Details: err.Error(),
-			Title:   "Server Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
 		return false
 	}
 	defer f.Close()
@@ -280,12 +228,7 @@ func savePostBody(ctx *fasthttp.RequestCtx, fpath string) (ok bool) {
 
 	_, err = io.Copy(f, r)
 	if err != nil {
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Details: err.Error(),
-			Title:   "Server Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
 		return false
 	}
 	return true
@@ -310,16 +253,11 @@ func respondWithFile(ctx *fasthttp.RequestCtx, statusCode int, fpath string) (ok
 	filePath := filepath.Join(filepath.Clean(fpath))
 	f, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
 	if err != nil && os.IsNotExist(err) {
-		ctx.SetStatusCode(404)
+		returnErrorCode(ctx, model.ErrResourceNotFound, "The requested file does not exist")
 		return false
 
 	} else if err != nil {
-		returnError(ctx, 500, model.Error{
-			Code:    500,
-			Details: err.Error(),
-			Title:   "Server Error",
-			Href:    "",
-		})
+		returnErrorCode(ctx, model.ErrInternal, err.Error())
 
 		return false
 	}
@@ -336,24 +274,23 @@ func respondWithFile(ctx *fasthttp.RequestCtx, statusCode int, fpath string) (ok
 		return err == nil
 	}
 
-	returnError(ctx, 500, model.Error{
-		Code:    500,
-		Details: err.Error(),
-		Title:   "Server Error",
-		Href:    "",
-	})
+	returnErrorCode(ctx, model.ErrInternal, err.Error())
 
 	return false
 }
 
+// SaveToJSONFile writes the message to the file. Fields with default values are included
+// as settings may have a default which differs from the zero value (e.g. sent_status)
 func SaveToJSONFile(in proto.Message, path string) error {
 	filePath := filepath.Clean(path)
-	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0600)
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
-	return marsheler.Marshal(file, in)
+	m := marsheler
+	m.EmitDefaults = true
+	return m.Marshal(file, in)
 }
 
 func isEncodingAllowed(ctx *fasthttp.RequestCtx, encoding string) bool {
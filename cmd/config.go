@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/ron96G/whatsapp-bizapi-mock/api"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/util"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+)
+
+// validateConfig loads and validates the config like the server does on startup
+// and prints the effective config. It returns the exit code of the command.
+func validateConfig(output string) int {
+	if _, err := loadConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, "invalid config:", err)
+		return 1
+	}
+	cfg := api.Config
+
+	errs := []error{cfg.Validate()}
+	_, err := util.NewTLSClient(cfg.WebhookCA, cfg.WebhookClientCert, cfg.WebhookClientKey, false)
+	errs = append(errs, err)
+	for _, target := range cfg.WebhookTargets {
+		_, err := webhook.NewTargetFromConfig(target, cfg.Version)
+		errs = append(errs, err)
+	}
+	_, err = model.NewGenerators(cfg.UploadDir, api.ContactsFromConfig(cfg), cfg.InboundMedia)
+	errs = append(errs, err)
+
+	valid := true
+	for _, err := range errs {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "invalid config:", err)
+			valid = false
+		}
+	}
+	if !valid {
+		return 1
+	}
+
+	var data []byte
+	if output == "yaml" {
+		data, err = api.MarshalYAML(cfg)
+	} else {
+		buf := &bytes.Buffer{}
+		m := jsonpb.Marshaler{OrigName: true, EmitDefaults: true, Indent: "  "}
+		err = m.Marshal(buf, cfg)
+		data = append(buf.Bytes(), '\n')
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unable to print config:", err)
+		return 1
+	}
+	os.Stdout.Write(data)
+	return 0
+}
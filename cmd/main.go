@@ -2,8 +2,13 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
@@ -18,6 +23,7 @@ import (
 	"github.com/ron96G/whatsapp-bizapi-mock/api"
 	"github.com/ron96G/whatsapp-bizapi-mock/docs"
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
 	"github.com/ron96G/whatsapp-bizapi-mock/util"
 	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
 
@@ -25,17 +31,26 @@ import (
 	log "github.com/ron96G/go-common-utils/log"
 )
 
+const defaultWebhookURL = "https://localhost:9000/webhook"
+
 var (
 	app = kingpin.New("wabiz-api-mock", "A WhatsApp Business API Mockserver")
 
+	serveCmd          = app.Command("serve", "start the mockserver").Default()
+	configCmd         = app.Command("config", "manage the configuration")
+	configValidateCmd = configCmd.Command("validate", "validate the config and print the effective config including the overrides of the environment and flags")
+	configOutput      = configValidateCmd.Flag("output", "the format of the printed config (json, yaml)").Short('o').Default("json").Enum("json", "yaml")
+
 	// required
-	configfile = app.Flag("configfile", "the configuration of the application").OverrideDefaultFromEnvar("WA_CONFIGFILE").Required().String()
+	configfile = app.Flag("configfile", "the configuration of the application (JSON or YAML). Fields can be overridden by "+api.ConfigEnvPrefix+"<FIELD> environment variables").OverrideDefaultFromEnvar("WA_CONFIGFILE").Required().String()
 
 	// optional
 	apiPrefix              = app.Flag("apiprefix", "the prefix for the API").Default("/v1").OverrideDefaultFromEnvar("WA_API_PREFIX").String()
 	addr                   = app.Flag("addr", "the address the API will listen on").Default("0.0.0.0:9090").OverrideDefaultFromEnvar("WA_ADDR").String()
-	webhookURL             = app.Flag("webhook", "the default webhook url").Default("https://localhost:9000/webhook").OverrideDefaultFromEnvar("WA_WEBHOOK").String()
+	webhookURL             = app.Flag("webhook", "the default webhook url. Overrides the url of the config. If the config has no url, "+defaultWebhookURL+" is used").OverrideDefaultFromEnvar("WA_WEBHOOK").String()
 	disableTLS             = app.Flag("disableTLS", "run the API with tls disabled").OverrideDefaultFromEnvar("WA_TLS_ENABLED").Bool()
+	webhookClientCert      = app.Flag("webhookClientCert", "path to the PEM encoded client certificate used for mutual TLS with the webhook").OverrideDefaultFromEnvar("WA_WEBHOOK_CLIENT_CERT").String()
+	webhookClientKey       = app.Flag("webhookClientKey", "path to the PEM encoded private key of the webhook client certificate").OverrideDefaultFromEnvar("WA_WEBHOOK_CLIENT_KEY").String()
 	insecureSkipVerify     = app.Flag("insecureSkipVerify", "do not validate the certificate of the webhook").OverrideDefaultFromEnvar("WA_INSECURE_SKIP_VERIFY").Bool()
 	soReuseport            = app.Flag("reuseport", "(experimental) uses SO_REUSEPORT option to start TCP listener").Bool() // see https://www.nginx.com/blog/socket-sharding-nginx-release-1-9-1/
 	compressWebhookContent = app.Flag("compress", "compress the content of the webhook requests using gzip").Bool()
@@ -45,56 +60,171 @@ var (
 	logformat              = app.Flag("logformat", "set the logformat for the application").Default("json").String()
 	graceperiod            = app.Flag("graceperiod", "duration to wait for the api to shutdown").Default("5s").Duration()
 	requestLimit           = app.Flag("requestlimit", "set a requestlimit (req/s) for specific endpoints").Default("20").Uint()
+	webhookQueueSize       = app.Flag("webhookQueueSize", "the maximum number of queued requests per webhook target").Default("1000").OverrideDefaultFromEnvar("WA_WEBHOOK_QUEUE_SIZE").Int()
+	webhookOverflowPolicy  = app.Flag("webhookOverflowPolicy", "behaviour if a webhook queue is full (block, drop_oldest, reject)").Default("block").OverrideDefaultFromEnvar("WA_WEBHOOK_OVERFLOW_POLICY").Enum("block", "drop_oldest", "reject")
+	webhookQueueTimeout    = app.Flag("webhookQueueTimeout", "duration to wait for space in a full webhook queue with policy block").Default("5s").Duration()
+	messagingTier          = app.Flag("messagingTier", "the messaging tier which limits the unique recipients in 24 hours (TIER_1K, TIER_10K, TIER_100K, TIER_UNLIMITED)").OverrideDefaultFromEnvar("WA_MESSAGING_TIER").Enum("", "TIER_1K", "TIER_10K", "TIER_100K", "TIER_UNLIMITED")
+	stateDir               = app.Flag("stateDir", "the directory in which the state is persisted. The stored state takes precedence over the config file. If empty, the state is only kept in memory").OverrideDefaultFromEnvar("WA_STATE_DIR").String()
+	watchConfig            = app.Flag("watchConfig", "reload the config file if it is changed. The config is also reloaded on SIGHUP").OverrideDefaultFromEnvar("WA_WATCH_CONFIG").Bool()
+	tokenTTL               = app.Flag("tokenTTL", "the lifetime of the tokens which are issued on login").Default("168h").OverrideDefaultFromEnvar("WA_TOKEN_TTL").Duration()
+	tokenSigningKey        = app.Flag("tokenSigningKey", "the key which signs the tokens. If empty, a random key is generated and kept in the state").OverrideDefaultFromEnvar("WA_TOKEN_SIGNING_KEY").String()
+	loginMaxUserFailures   = app.Flag("loginMaxUserFailures", "the number of failed logins after which a user is locked. 0 disables the lock").Default("5").OverrideDefaultFromEnvar("WA_LOGIN_MAX_USER_FAILURES").Int()
+	loginMaxIPFailures     = app.Flag("loginMaxIPFailures", "the number of failed logins after which a source IP is locked. 0 disables the lock").Default("20").OverrideDefaultFromEnvar("WA_LOGIN_MAX_IP_FAILURES").Int()
+	loginLockoutDuration   = app.Flag("loginLockoutDuration", "the duration of a login lock").Default("15m").OverrideDefaultFromEnvar("WA_LOGIN_LOCKOUT_DURATION").Duration()
+	controlPrefix          = app.Flag("controlPrefix", "the prefix of the mock-only control endpoints, e.g. to generate webhook requests").Default("/mock").OverrideDefaultFromEnvar("WA_CONTROL_PREFIX").String()
+	controlAddr            = app.Flag("controlAddr", "the address the control endpoints will listen on. If empty, they are served next to the API").OverrideDefaultFromEnvar("WA_CONTROL_ADDR").String()
+	controlAPIKey          = app.Flag("controlAPIKey", "the API key of the control endpoints. Defaults to WA_API_KEY. If both are empty and no client CA is set, a random key is generated and logged").OverrideDefaultFromEnvar("WA_CONTROL_API_KEY").String()
+	controlClientCA        = app.Flag("controlClientCA", "path to the PEM encoded CA which verifies client certificates (mTLS) of the control endpoints").OverrideDefaultFromEnvar("WA_CONTROL_CLIENT_CA").String()
 	maxStatiPerWebhook     = app.Flag("maxStatiPerWebhook", "set the maximum amout of stati that will be sent in a single webhook").Default("1000").Int()
 
-	staticAPIToken = os.Getenv("WA_API_KEY")
+	// the API key of the control endpoints before there was a separate flag
+	legacyAPIKey = os.Getenv("WA_API_KEY")
 )
 
-func setupConfig(path string) error {
-	filePath := filepath.Clean(path)
-	f, err := os.Open(filePath)
-	if err != nil {
+func setupConfig(path string) (err error) {
+	api.Config, err = api.ReadConfigFile(path)
+	return err
+}
+
+func setupWebhookClientCert(certPath, keyPath string) (err error) {
+	if api.Config.WebhookClientCert, err = ioutil.ReadFile(filepath.Clean(certPath)); err != nil {
 		return err
 	}
-	defer f.Close()
+	api.Config.WebhookClientKey, err = ioutil.ReadFile(filepath.Clean(keyPath))
+	return err
+}
 
-	return api.InitConfig(f)
+// setupControlAPIKey returns the API key of the control endpoints. Without a configured key and client CA,
+// a random key is generated, so that the control endpoints are never unprotected.
+func setupControlAPIKey() (key string, generated bool, err error) {
+	if *controlAPIKey != "" {
+		return *controlAPIKey, false, nil
+	}
+	if legacyAPIKey != "" || *controlClientCA != "" {
+		return legacyAPIKey, false, nil
+	}
+	b := make([]byte, 24)
+	if _, err = rand.Read(b); err != nil {
+		return "", false, err
+	}
+	return hex.EncodeToString(b), true, nil
 }
 
-func main() {
-	start := time.Now()
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+// setupControlTLS requires client certificates which are signed by the CA of the file
+func setupControlTLS(tlsCfg *tls.Config, caPath string) (*tls.Config, error) {
+	ca, err := ioutil.ReadFile(filepath.Clean(caPath))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", caPath)
+	}
+	tlsCfg = tlsCfg.Clone()
+	tlsCfg.ClientCAs = pool
+	// requests without a client certificate may still use the API key
+	tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
+	return tlsCfg, nil
+}
 
-	log.Configure(*loglevel, *logformat, os.Stdout)
-	mainLogger := log.New("main_logger")
+// listen creates the TCP listener of the address which uses TLS if tlsCfg is set
+func listen(addr string, tlsCfg *tls.Config) (ln net.Listener, err error) {
+	if *soReuseport {
+		ln, err = reuseport.Listen("tcp4", addr)
+	} else {
+		ln, err = net.Listen("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		ln = tls.NewListener(ln, tlsCfg)
+	}
+	return ln, nil
+}
 
+func setupStore(dir string) (store.Store, error) {
+	if dir == "" {
+		return store.NewMemoryStore(), nil
+	}
+	return store.NewFileStore(dir)
+}
+
+// loadConfig reads the config file and the stored state and applies the flags which override fields of the config
+func loadConfig() (store.Store, error) {
 	if *configfile != "" {
-		mainLogger.Info("Trying to setup config", "configfile", *configfile)
 		if err := setupConfig(*configfile); err != nil {
-			mainLogger.Crit("Failed to setup config", "configfile", *configfile, "error", err)
-			os.Exit(1)
+			return nil, fmt.Errorf("failed to setup config: %v", err)
+		}
+	}
+
+	st, err := setupStore(*stateDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup state store: %v", err)
+	}
+	found, err := api.LoadConfigState(st, api.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stored state: %v", err)
+	}
+	// the stored state replaces the config file, but not the overrides of the environment
+	if found {
+		if api.Config, err = api.ApplyConfigEnvOverrides(api.Config, os.Environ()); err != nil {
+			return nil, fmt.Errorf("failed to apply environment to stored state: %v", err)
 		}
 	}
 
-	util.NewClient(api.Config.WebhookCA)
+	if *webhookClientCert != "" || *webhookClientKey != "" {
+		if err := setupWebhookClientCert(*webhookClientCert, *webhookClientKey); err != nil {
+			return nil, fmt.Errorf("failed to read webhook client certificate: %v", err)
+		}
+	}
 
 	if *webhookURL != "" {
 		api.Config.ApplicationSettings.Webhooks.Url = *webhookURL
+	} else if api.Config.ApplicationSettings.Webhooks.Url == "" {
+		api.Config.ApplicationSettings.Webhooks.Url = defaultWebhookURL
 	}
 
-	util.DefaultClient.TLSConfig.InsecureSkipVerify = *insecureSkipVerify
-	api.UpdateUnmarshaler(*allowUnknownFields)
+	if *messagingTier != "" {
+		api.Config.MessagingTier = model.MessagingTier(model.MessagingTier_value[*messagingTier])
+	}
+	return st, nil
+}
 
-	contacts := make([]*model.Contact, len(api.Config.Contacts))
-	for i, c := range api.Config.Contacts {
-		contacts[i] = &model.Contact{
-			WaId: c.Id,
-			Profile: &model.Contact_Profile{
-				Name: c.Name,
-			},
-		}
+func main() {
+	start := time.Now()
+	command := kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	if command == configValidateCmd.FullCommand() {
+		// the config is printed to stdout
+		log.Configure(*loglevel, *logformat, os.Stderr)
+		os.Exit(validateConfig(*configOutput))
+	}
+
+	log.Configure(*loglevel, *logformat, os.Stdout)
+	mainLogger := log.New("main_logger")
+
+	mainLogger.Info("Trying to setup config", "configfile", *configfile)
+	stateStore, err := loadConfig()
+	if err != nil {
+		mainLogger.Crit("Failed to setup config", "configfile", *configfile, "error", err)
+		os.Exit(1)
 	}
 
+	// the certificates of the config are only used by the webhook client of the API, not by the tenants
+	if _, err := util.NewTLSConfig(api.Config.WebhookCA, api.Config.WebhookClientCert, api.Config.WebhookClientKey, *insecureSkipVerify); err != nil {
+		mainLogger.Crit("Failed to setup webhook client", "error", err)
+		os.Exit(1)
+	}
+	if err := util.NewClient(nil, nil, nil, *insecureSkipVerify); err != nil {
+		mainLogger.Crit("Failed to setup webhook client", "error", err)
+		os.Exit(1)
+	}
+
+	api.UpdateUnmarshaler(*allowUnknownFields)
+
+	contacts := api.ContactsFromConfig(api.Config)
+
 	// setup  swagger
 
 	if *disableTLS {
@@ -113,14 +243,65 @@ func main() {
 		os.Exit(1)
 	}
 	wh := webhook.NewWebhook(api.Config.ApplicationSettings.Webhooks.Url, api.Config.Version, generators)
-	wh.Compress = *compressWebhookContent
-	wh.CompressMinsize = *compressMinsize
+	wh.Default().Compress = *compressWebhookContent
+	wh.Default().CompressMinsize = *compressMinsize
+	wh.Default().MaxConcurrentRequests = int(api.Config.ApplicationSettings.Webhooks.MaxConcurrentRequests)
 	wh.MaxStatiPerWebhookRequest = *maxStatiPerWebhook
+	wh.Chaos.Set(api.Config.WebhookChaos)
+
+	for _, cfg := range api.Config.WebhookTargets {
+		target, err := webhook.NewTargetFromConfig(cfg, api.Config.Version)
+		if err != nil {
+			mainLogger.Crit("Failed to create webhook target", "error", err)
+			os.Exit(1)
+		}
+		if err = wh.AddTarget(target); err != nil {
+			mainLogger.Crit("Failed to add webhook target", "error", err)
+			os.Exit(1)
+		}
+		mainLogger.Info("Added webhook target", "target", target.Name, "url", target.URL())
+	}
+
+	overflowPolicy, err := webhook.ParseOverflowPolicy(*webhookOverflowPolicy)
+	if err != nil {
+		mainLogger.Crit("Failed to configure webhook queue", "error", err)
+		os.Exit(1)
+	}
+	wh.ConfigureQueues(*webhookQueueSize, overflowPolicy, *webhookQueueTimeout)
+
+	api.TokenValidDuration = *tokenTTL
+	api.SigningKey = []byte(*tokenSigningKey)
+	api.LoginMaxUserFailures = *loginMaxUserFailures
+	api.LoginMaxIPFailures = *loginMaxIPFailures
+	api.LoginLockoutDuration = *loginLockoutDuration
+	if *controlClientCA != "" && *disableTLS {
+		mainLogger.Crit("Client certificates of the control endpoints require TLS")
+		os.Exit(1)
+	}
+	apiKey, generatedKey, err := setupControlAPIKey()
+	if err != nil {
+		mainLogger.Crit("Failed to generate control API key", "error", err)
+		os.Exit(1)
+	}
+	if generatedKey {
+		mainLogger.Warn("No API key is configured for the control endpoints. Using a generated key", "apikey", apiKey)
+	}
 
-	apiServer := api.NewAPI(*apiPrefix, staticAPIToken, *requestLimit, api.Config, wh)
+	api.ControlPrefix = *controlPrefix
+	api.SeparateControlServer = *controlAddr != ""
+	apiServer := api.NewAPI(*apiPrefix, apiKey, *requestLimit, api.Config, wh)
+	if err = apiServer.UseStore(stateStore); err != nil {
+		mainLogger.Crit("Failed to setup state store", "error", err)
+		os.Exit(1)
+	}
+	if err = apiServer.EnableTenants(); err != nil {
+		mainLogger.Crit("Failed to start tenants", "error", err)
+		os.Exit(1)
+	}
 
 	errors := make(chan error, 5)
 	stopWebhook := wh.Run(errors)
+	stopTokenSweeper := apiServer.RunTokenSweeper(api.TokenSweepInterval)
 
 	go func() {
 		for {
@@ -128,26 +309,11 @@ func main() {
 			mainLogger.Error("Async error occured", "error", err)
 		}
 	}()
-	var ln net.Listener
-
-	if *soReuseport {
-		ln, err = reuseport.Listen("tcp4", *addr)
-		if err != nil {
-			mainLogger.Crit("Failed to create reuseport tcp listener", "addr", *addr, "error", err)
-			os.Exit(1)
-		}
-	} else {
-		ln, err = net.Listen("tcp", *addr)
-		if err != nil {
-			mainLogger.Crit("Failed to create tcp listener", "addr", *addr, "error", err)
-			os.Exit(1)
-		}
-	}
-	defer ln.Close()
 
+	var tlsCfg, controlTLSCfg *tls.Config
 	if !*disableTLS {
 		mainLogger.Debug("Creating new Server TLS config as TLS is enabled")
-		tlsCfg, err := cert.GenerateServerTLS(cert.Options{
+		tlsCfg, err = cert.GenerateServerTLS(cert.Options{
 			Subject: pkix.Name{
 				Organization: []string{"WhatsApp Mockserver Fake Certificate"},
 				Country:      []string{"DE"},
@@ -159,11 +325,61 @@ func main() {
 			mainLogger.Crit("Unable to generate Server TLS config", "error", err)
 			os.Exit(1)
 		}
-		ln = tls.NewListener(ln, tlsCfg)
+		controlTLSCfg = tlsCfg
+		if *controlClientCA != "" {
+			if controlTLSCfg, err = setupControlTLS(tlsCfg, *controlClientCA); err != nil {
+				mainLogger.Crit("Failed to setup client certificates of the control endpoints", "clientCA", *controlClientCA, "error", err)
+				os.Exit(1)
+			}
+			// the control endpoints are served by the API listener
+			if *controlAddr == "" {
+				tlsCfg = controlTLSCfg
+			}
+		}
+	}
+
+	ln, err := listen(*addr, tlsCfg)
+	if err != nil {
+		mainLogger.Crit("Failed to create tcp listener", "addr", *addr, "error", err)
+		os.Exit(1)
+	}
+	defer ln.Close()
+
+	if *controlAddr != "" {
+		controlLn, err := listen(*controlAddr, controlTLSCfg)
+		if err != nil {
+			mainLogger.Crit("Failed to create control tcp listener", "addr", *controlAddr, "error", err)
+			os.Exit(1)
+		}
+		defer controlLn.Close()
+
+		go func() {
+			mainLogger.Info("Starting control server", "addr", *controlAddr, "prefix", *controlPrefix)
+			if err := apiServer.ControlServer.Serve(controlLn); err != nil && err != http.ErrServerClosed {
+				mainLogger.Crit("Failed to start control server with listener", "addr", *controlAddr, "error", err)
+				os.Exit(1)
+			}
+		}()
 	}
 
 	stopChan := SetupSignalHandler()
 
+	reloadConfig := func() {
+		mainLogger.Info("Reloading config", "configfile", *configfile)
+		if _, err := apiServer.ReloadConfigFile(*configfile); err != nil {
+			mainLogger.Error("Failed to reload config. Keeping the current config", "configfile", *configfile, "error", err)
+		}
+	}
+	SetupReloadHandler(reloadConfig)
+
+	stopWatcher := func() error { return nil }
+	if *watchConfig {
+		if stopWatcher, err = api.WatchConfigFile(*configfile, reloadConfig); err != nil {
+			mainLogger.Crit("Failed to watch config file", "configfile", *configfile, "error", err)
+			os.Exit(1)
+		}
+	}
+
 	go func() {
 		mainLogger.Info("Setup completed", "elapsed_time", time.Since(start).Milliseconds())
 		mainLogger.Info("Starting webserver", "addr", *addr)
@@ -179,15 +395,25 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), *graceperiod)
 
 	go func() {
+		stopWatcher()
+		stopTokenSweeper()
 		stopWebhook <- 1
+		apiServer.Stop()
+		apiServer.Tenants.Stop()
 		apiServer.Server.Shutdown()
+		if *controlAddr != "" {
+			apiServer.ControlServer.Shutdown()
+		}
 		cancel()
 	}()
 
 	<-shutdownCtx.Done()
 
-	if err = api.SaveToJSONFile(apiServer.Config, *configfile); err != nil {
-		mainLogger.Crit("Unable to save current config", "error", err)
+	// without a state directory, the state is saved to the config file on shutdown
+	if *stateDir == "" {
+		if err = api.SaveConfigFile(apiServer.Config.Current().InternalConfig, *configfile); err != nil {
+			mainLogger.Crit("Unable to save current config", "error", err)
+		}
 	}
 	mainLogger.Info("Successfully shutdown application")
 }
@@ -205,3 +431,14 @@ func SetupSignalHandler() (stopCh <-chan struct{}) {
 
 	return stop
 }
+
+// SetupReloadHandler calls reload whenever a SIGHUP is received
+func SetupReloadHandler(reload func()) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		for range c {
+			reload()
+		}
+	}()
+}
@@ -23,6 +23,109 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/certificates/webhooks/ca": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a PEM encoded CA certificate which is used to validate the certificate of the webhook",
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Upload the CA certificate for the webhook",
+                "parameters": [
+                    {
+                        "description": "the PEM encoded CA certificate",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificates/webhooks/client": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a PEM encoded client certificate and its private key which are used for mutual TLS with the webhook",
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Upload the client certificate for the webhook",
+                "parameters": [
+                    {
+                        "description": "the PEM encoded certificate and private key",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The gateway_status is connected, disconnected, uninitialized or unregistered. Multiconnect setups return one entry per node",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Get the health of the gateway",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/media": {
             "post": {
                 "security": [
@@ -134,37 +237,35 @@ var doc = `{
                 }
             }
         },
-        "/users": {
-            "post": {
+        "/messages/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "An admin may use this endpoint to create a new user",
+                "description": "Retrieve an inbound or outbound message and its stati. Messages are only kept if pass_through is disabled",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "messages"
                 ],
-                "summary": "create a new user in the application",
+                "summary": "Retrieve a message",
                 "parameters": [
                     {
-                        "description": "username and password of the user",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.User"
-                        }
+                        "type": "string",
+                        "description": "ID of the message",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.LoginResponse"
+                            "$ref": "#/definitions/model.WebhookRequest"
                         }
                     },
                     "default": {
@@ -176,26 +277,26 @@ var doc = `{
                 }
             }
         },
-        "/users/login": {
-            "post": {
+        "/mock/account/inbox": {
+            "get": {
                 "security": [
                     {
-                        "BasicAuth": []
+                        "ControlAuth": []
                     }
                 ],
-                "description": "Login into the application using basic auth",
+                "description": "Get the registration codes which have been sent via sms or voice",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "mock"
                 ],
-                "summary": "Login into the application",
+                "summary": "Read the inbox of the fake phone",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.LoginResponse"
+                            "$ref": "#/definitions/model.RegistrationInbox"
                         }
                     },
                     "default": {
@@ -207,24 +308,26 @@ var doc = `{
                 }
             }
         },
-        "/users/logout": {
-            "post": {
+        "/mock/admin/lockouts": {
+            "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "ControlAuth": []
                     }
                 ],
-                "description": "Logout by supplying a bearer token of the user that should be logged out",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Log the user out",
+                "summary": "List the users and source IPs which are locked after too many failed logins",
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LockoutList"
+                        }
                     },
                     "default": {
                         "description": "",
@@ -233,30 +336,30 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{username}": {
+            },
             "delete": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "ControlAuth": []
                     }
                 ],
-                "description": "An admin may use this endpoint to delete an existing user",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Removes the failed logins of the user and/or the source IP. Without both, all locks are removed",
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "delete an existing user in the application",
+                "summary": "Unlock users and source IPs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Name of the user that should be deleted",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
+                        "description": "name of the user",
+                        "name": "user",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "source IP",
+                        "name": "ip",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -271,86 +374,2137 @@ var doc = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "model.Error": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "details": {
-                    "type": "string"
-                },
-                "href": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                }
-            }
         },
-        "model.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "errors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Error"
+        "/mock/admin/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Returns the user, role, issue and expiry time of all active tokens",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "List the active sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SessionList"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
                     }
-                },
-                "meta": {
-                    "$ref": "#/definitions/model.Meta"
-                }
-            }
-        },
-        "model.Id": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
                 }
             }
         },
-        "model.IdResponse": {
-            "type": "object",
-            "properties": {
-                "media": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Id"
+        "/mock/admin/tenants": {
+            "get": {
+                "security": [
+                    {
+                        "ControlAuth": []
                     }
-                },
-                "messages": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Id"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List all tenants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tenants"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "model.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "meta": {
-                    "$ref": "#/definitions/model.Meta"
-                },
-                "users": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.TokenResponse"
+            },
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
                     }
-                }
-            }
-        },
-        "model.Meta": {
-            "type": "object",
-            "properties": {
-                "api_status": {
-                    "type": "integer"
-                },
-                "version": {
+                ],
+                "description": "Create an isolated account which is selected by host name, path prefix or the X-Tenant header.\nThe users of the tenant are taken from config.users. Without users, the admin has the default password which has to be changed on the first login",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Create a tenant",
+                "parameters": [
+                    {
+                        "description": "the tenant",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Tenant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Tenant"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/admin/tenants/{name}": {
+            "delete": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Remove a tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the tenant",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/faults": {
+            "get": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "List all fault injection rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.FaultRules"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Inject errors, latency or dropped connections into the API requests which match the rule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Add a fault injection rule",
+                "parameters": [
+                    {
+                        "description": "the fault rule",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FaultRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.FaultRule"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Delete all fault injection rules",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/faults/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Delete a fault injection rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the rule",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/health/gateway": {
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Simulate a disconnected or uninitialized gateway. If the gateway is disconnected, messages cannot be sent",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Set the connectivity of the gateway",
+                "parameters": [
+                    {
+                        "description": "the connectivity of the gateway or its nodes",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GatewayConnectivity"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/settings/account/quality": {
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Set the block and failure rates of the simulated recipients or override the quality rating",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Configure the signals of the quality rating",
+                "parameters": [
+                    {
+                        "description": "the quality signals",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.QualitySignals"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quality"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/settings/account/tier": {
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Set the tier which limits the unique recipients of business-initiated messages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Set the messaging tier",
+                "parameters": [
+                    {
+                        "description": "only the tier is used",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MessagingUsage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MessagingUsage"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mock/webhook/chaos": {
+            "get": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Get the chaos mode of the webhook",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookChaos"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ControlAuth": []
+                    }
+                ],
+                "description": "Duplicate, reorder, delay, split or drop webhook requests to test the idempotency of the receiver",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock"
+                ],
+                "summary": "Configure the chaos mode of the webhook",
+                "parameters": [
+                    {
+                        "description": "the chaos configuration",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookChaos"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookChaos"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/settings/account/quality": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Get the quality rating of the phone number",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Quality"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/settings/account/two-step": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the 6-digit PIN which is required to register the number again",
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Enable two-step verification",
+                "parameters": [
+                    {
+                        "description": "the PIN",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TwoStepRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Disable two-step verification",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/app": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the message, status and callback stats. Use format=prometheus to get the prometheus text format",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get the application stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json (default) or prometheus",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatsResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/db": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of stored entities. Use format=prometheus to get the prometheus text format",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get the database stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json (default) or prometheus",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatsResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/messaging": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of unique recipients of business-initiated messages in the rolling 24 hour window",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get the usage of the messaging tier",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MessagingUsage"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "list the users of the application",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserList"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "An admin may use this endpoint to create a new user. Without a role, the user is created as USER",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "create a new user in the application",
+                "parameters": [
+                    {
+                        "description": "username, password and role of the user",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Login into the application using basic auth",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Login into the application",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logout by supplying a bearer token of the user that should be logged out",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Log the user out",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "An admin may get every user, all other users only themselves",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "get a user of the application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the user",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserInfo"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "An admin may update every user, all other users only their own password",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "change the password or the role of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the user",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the new password and/or role",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserInfo"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "An admin may use this endpoint to delete an existing user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "delete an existing user in the application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the user that should be deleted",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model.AccountEvent": {
+            "type": "object",
+            "properties": {
+                "current_limit": {
+                    "type": "integer"
+                },
+                "event": {
+                    "description": "FLAGGED, UNFLAGGED, DOWNGRADE or UPDATE",
+                    "type": "string"
+                },
+                "previous_quality_rating": {
+                    "type": "integer"
+                },
+                "quality_rating": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "quality_update",
+                    "type": "string"
+                }
+            }
+        },
+        "model.AppStats": {
+            "type": "object",
+            "properties": {
+                "callback_queue_size": {
+                    "description": "number of queued webhook requests by target",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "callbacks": {
+                    "description": "sent webhook requests by target",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.CallbackStats"
+                    }
+                },
+                "inbound_messages": {
+                    "description": "number of messages by type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "outbound_messages": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "statuses": {
+                    "description": "number of statuses by status",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "model.ApplicationSettings": {
+            "type": "object",
+            "properties": {
+                "callback_backoff_delay_ms": {
+                    "type": "integer"
+                },
+                "callback_persist": {
+                    "type": "boolean"
+                },
+                "db_garbagecollector_enable": {
+                    "type": "boolean"
+                },
+                "max_callback_backoff_delay_ms": {
+                    "type": "integer"
+                },
+                "media": {
+                    "$ref": "#/definitions/model.ApplicationSettings_Media"
+                },
+                "notify_user_change_number": {
+                    "type": "boolean"
+                },
+                "pass_through": {
+                    "type": "boolean"
+                },
+                "sent_status": {
+                    "type": "boolean"
+                },
+                "show_security_notifications": {
+                    "type": "boolean"
+                },
+                "webhooks": {
+                    "$ref": "#/definitions/model.ApplicationSettings_Webhooks"
+                }
+            }
+        },
+        "model.ApplicationSettings_Media": {
+            "type": "object",
+            "properties": {
+                "auto_download": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.ApplicationSettings_Webhooks": {
+            "type": "object",
+            "properties": {
+                "max_concurrent_requests": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AudioMessage": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/model.Provider"
+                },
+                "sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.BusinessProfile": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "vertical": {
+                    "type": "string"
+                },
+                "websites": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CallbackStats": {
+            "type": "object",
+            "properties": {
+                "avg_latency_seconds": {
+                    "type": "number"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "sent": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Contact": {
+            "type": "object",
+            "properties": {
+                "input": {
+                    "type": "string"
+                },
+                "profile": {
+                    "$ref": "#/definitions/model.Contact_Profile"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "wa_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Contact_Profile": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Context": {
+            "type": "object",
+            "properties": {
+                "forwarded": {
+                    "type": "boolean"
+                },
+                "frequently_forwarded": {
+                    "type": "boolean"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.Conversation": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DBStats": {
+            "type": "object",
+            "properties": {
+                "contacts": {
+                    "type": "integer"
+                },
+                "pending_statuses": {
+                    "type": "integer"
+                },
+                "sessions": {
+                    "type": "integer"
+                },
+                "stored_messages": {
+                    "type": "integer"
+                },
+                "unique_recipients": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.DocumentMessage": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/model.Provider"
+                },
+                "sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Error": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "href": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Error"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/model.Meta"
+                }
+            }
+        },
+        "model.FaultRule": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "regular expression which is matched against the request body",
+                    "type": "string"
+                },
+                "count": {
+                    "description": "maximum number of injected faults. 0 is unlimited",
+                    "type": "integer"
+                },
+                "dropConnection": {
+                    "description": "close the connection without sending a response",
+                    "type": "boolean"
+                },
+                "errorCode": {
+                    "description": "code of the error catalogue which is returned as the response body",
+                    "type": "integer"
+                },
+                "errorDetails": {
+                    "type": "string"
+                },
+                "header": {
+                    "description": "name and regular expression of a header which must match",
+                    "type": "string"
+                },
+                "headerPattern": {
+                    "type": "string"
+                },
+                "hits": {
+                    "description": "number of injected faults, set by the server",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "id is assigned by the server",
+                    "type": "string"
+                },
+                "latencyMs": {
+                    "description": "latency which is added before the request is handled or the fault is returned",
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "regular expression which is matched against the path of the request",
+                    "type": "string"
+                },
+                "probability": {
+                    "description": "probability that a matching request is faulted. 0 always injects the fault",
+                    "type": "number"
+                },
+                "statusCode": {
+                    "description": "status code of the response. If errorCode is set, the status code of the catalogue is used by default",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.FaultRules": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FaultRule"
+                    }
+                }
+            }
+        },
+        "model.GatewayConnectivity": {
+            "type": "object",
+            "properties": {
+                "gateway_status": {
+                    "description": "connected, disconnected or uninitialized",
+                    "type": "string"
+                },
+                "nodes": {
+                    "description": "gateway status of each node of a multiconnect setup",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.Id": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IdResponse": {
+            "type": "object",
+            "properties": {
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Id"
+                    }
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Id"
+                    }
+                }
+            }
+        },
+        "model.ImageMessage": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/model.Provider"
+                },
+                "sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/model.InteractiveMessage_ActionWrapper"
+                },
+                "body": {
+                    "$ref": "#/definitions/model.InteractiveMessage_TextParameter"
+                },
+                "footer": {
+                    "$ref": "#/definitions/model.InteractiveMessage_TextParameter"
+                },
+                "header": {
+                    "$ref": "#/definitions/model.InteractiveMessage_HeaderParameter"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage_ActionWrapper": {
+            "type": "object",
+            "properties": {
+                "button": {
+                    "type": "string"
+                },
+                "buttons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InteractiveMessage_ButtonsAction"
+                    }
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InteractiveMessage_Section"
+                    }
+                }
+            }
+        },
+        "model.InteractiveMessage_ButtonsAction": {
+            "type": "object",
+            "properties": {
+                "reply": {
+                    "$ref": "#/definitions/model.InteractiveMessage_ButtonsAction_ButtonsActionReply"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage_ButtonsAction_ButtonsActionReply": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage_HeaderParameter": {
+            "type": "object",
+            "properties": {
+                "document": {
+                    "$ref": "#/definitions/model.MediaParameter"
+                },
+                "image": {
+                    "$ref": "#/definitions/model.MediaParameter"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "video": {
+                    "$ref": "#/definitions/model.MediaParameter"
+                }
+            }
+        },
+        "model.InteractiveMessage_Section": {
+            "type": "object",
+            "properties": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InteractiveMessage_Section_SectionRow"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage_Section_SectionRow": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InteractiveMessage_TextParameter": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InternalConfig": {
+            "type": "object",
+            "properties": {
+                "applicationSettings": {
+                    "$ref": "#/definitions/model.ApplicationSettings"
+                },
+                "businessProfile": {
+                    "$ref": "#/definitions/model.BusinessProfile"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InternalContact"
+                    }
+                },
+                "inboundMedia": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "messagingTier": {
+                    "type": "integer"
+                },
+                "profileAbout": {
+                    "$ref": "#/definitions/model.ProfileAbout"
+                },
+                "profilePhotoFilename": {
+                    "type": "string"
+                },
+                "qualitySignals": {
+                    "$ref": "#/definitions/model.QualitySignals"
+                },
+                "registration": {
+                    "$ref": "#/definitions/model.Registration"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tenants": {
+                    "description": "additional isolated accounts which are served by the same process",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Tenant"
+                    }
+                },
+                "twoStepPinHash": {
+                    "description": "bcrypt hash of the two-step verification PIN",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "uploadDir": {
+                    "type": "string"
+                },
+                "userRecords": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.UserRecord"
+                    }
+                },
+                "users": {
+                    "description": "plaintext passwords of older configs. They are migrated to userRecords on load",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "verified": {
+                    "type": "boolean"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "webhookCA": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "webhookChaos": {
+                    "$ref": "#/definitions/model.WebhookChaos"
+                },
+                "webhookClientCert": {
+                    "description": "PEM encoded client certificate and key which are used for mutual TLS with the webhook",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "webhookClientKey": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "webhookTargets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookTarget"
+                    }
+                }
+            }
+        },
+        "model.InternalContact": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LocationMessage": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "integer"
+                },
+                "longitude": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Lockout": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "locked_until": {
+                    "type": "integer"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LockoutList": {
+            "type": "object",
+            "properties": {
+                "lockouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Lockout"
+                    }
+                }
+            }
+        },
+        "model.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/model.Meta"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TokenResponse"
+                    }
+                }
+            }
+        },
+        "model.MediaParameter": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Message": {
+            "type": "object",
+            "properties": {
+                "audio": {
+                    "$ref": "#/definitions/model.AudioMessage"
+                },
+                "context": {
+                    "$ref": "#/definitions/model.Context"
+                },
+                "document": {
+                    "$ref": "#/definitions/model.DocumentMessage"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Error"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "$ref": "#/definitions/model.ImageMessage"
+                },
+                "interactive": {
+                    "$ref": "#/definitions/model.InteractiveMessage"
+                },
+                "location": {
+                    "$ref": "#/definitions/model.LocationMessage"
+                },
+                "preview_url": {
+                    "type": "boolean"
+                },
+                "recipient_type": {
+                    "type": "integer"
+                },
+                "sticker": {
+                    "$ref": "#/definitions/model.StickerMessage"
+                },
+                "system": {
+                    "$ref": "#/definitions/model.SystemMessage"
+                },
+                "template": {
+                    "$ref": "#/definitions/model.TemplateMessage"
+                },
+                "text": {
+                    "$ref": "#/definitions/model.TextMessage"
+                },
+                "timestamp": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "integer"
+                },
+                "video": {
+                    "$ref": "#/definitions/model.VideoMessage"
+                },
+                "voice": {
+                    "$ref": "#/definitions/model.VoiceMessage"
+                }
+            }
+        },
+        "model.MessagingUsage": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "maximum number of unique recipients. 0 is unlimited",
+                    "type": "integer"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "tier": {
+                    "type": "integer"
+                },
+                "uniqueRecipients": {
+                    "type": "integer"
+                },
+                "windowSeconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Meta": {
+            "type": "object",
+            "properties": {
+                "api_status": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Pricing": {
+            "type": "object",
+            "properties": {
+                "billable": {
+                    "type": "boolean"
+                },
+                "pricing_model": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ProfileAbout": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Provider": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Quality": {
+            "type": "object",
+            "properties": {
+                "evaluated": {
+                    "type": "integer"
+                },
+                "flagged": {
+                    "type": "boolean"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "score": {
+                    "description": "share of negative signals of the evaluated messages",
+                    "type": "number"
+                },
+                "signals": {
+                    "$ref": "#/definitions/model.QualitySignals"
+                },
+                "tier": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.QualitySignals": {
+            "type": "object",
+            "properties": {
+                "blockRate": {
+                    "description": "probability that a simulated recipient blocks the business after receiving a message",
+                    "type": "number"
+                },
+                "failureRate": {
+                    "description": "probability that a sent message fails",
+                    "type": "number"
+                },
+                "override": {
+                    "description": "use overrideRating instead of the signals",
+                    "type": "boolean"
+                },
+                "overrideRating": {
+                    "type": "integer"
+                },
+                "redThreshold": {
+                    "type": "number"
+                },
+                "window": {
+                    "description": "number of recent messages which are evaluated",
+                    "type": "integer"
+                },
+                "yellowThreshold": {
+                    "description": "share of negative signals from which the rating is YELLOW or RED",
+                    "type": "number"
+                }
+            }
+        },
+        "model.Registration": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "number of failed verification attempts of the code",
+                    "type": "integer"
+                },
+                "cc": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "the code which has been sent to the phone number",
+                    "type": "string"
+                },
+                "codeExpiresAt": {
+                    "type": "integer"
+                },
+                "inbox": {
+                    "description": "the codes which have been received by the fake phone",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RegistrationCode"
+                    }
+                },
+                "method": {
+                    "type": "integer"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "requestedAt": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RegistrationCode": {
+            "type": "object",
+            "properties": {
+                "cc": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "integer"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "sentAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RegistrationInbox": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RegistrationCode"
+                    }
+                }
+            }
+        },
+        "model.Session": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "integer"
+                },
+                "hash": {
+                    "description": "the hex encoded SHA-256 hash of the token. It is only persisted and not returned by the API",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "the first 8 bytes of the SHA-256 hash of the token",
+                    "type": "string"
+                },
+                "issued_at": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SessionList": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Session"
+                    }
+                }
+            }
+        },
+        "model.Stats": {
+            "type": "object",
+            "properties": {
+                "app": {
+                    "$ref": "#/definitions/model.AppStats"
+                },
+                "db": {
+                    "$ref": "#/definitions/model.DBStats"
+                }
+            }
+        },
+        "model.StatsResponse": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/model.Meta"
+                },
+                "stats": {
+                    "$ref": "#/definitions/model.Stats"
+                }
+            }
+        },
+        "model.Status": {
+            "type": "object",
+            "properties": {
+                "conversation": {
+                    "$ref": "#/definitions/model.Conversation"
+                },
+                "errors": {
+                    "description": "the reason why a message failed, only set for the failed status",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Error"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pricing": {
+                    "$ref": "#/definitions/model.Pricing"
+                },
+                "recipient_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StickerMessage": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/model.Provider"
+                }
+            }
+        },
+        "model.SystemMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TemplateMessage": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TemplateMessage_Component"
+                    }
+                },
+                "language": {
+                    "$ref": "#/definitions/model.TemplateMessage_Language"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TemplateMessage_Component": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "string"
+                },
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TemplateMessage_Component_Parameter"
+                    }
+                },
+                "sub_type": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TemplateMessage_Component_Parameter": {
+            "type": "object",
+            "properties": {
+                "spec": {
+                    "description": "Types that are valid to be assigned to Spec:\n\t*TemplateMessage_Component_Parameter_Text\n\t*TemplateMessage_Component_Parameter_Currency\n\t*TemplateMessage_Component_Parameter_DateTime\n\t*TemplateMessage_Component_Parameter_Image\n\t*TemplateMessage_Component_Parameter_Document\n\t*TemplateMessage_Component_Parameter_Video\n\t*TemplateMessage_Component_Parameter_Payload",
+                    "$ref": "#/definitions/model.isTemplateMessage_Component_Parameter_Spec"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TemplateMessage_Language": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "policy": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Tenant": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "description": "the config of the tenant. Missing values are set to the defaults",
+                    "$ref": "#/definitions/model.InternalConfig"
+                },
+                "hosts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Tenants": {
+            "type": "object",
+            "properties": {
+                "tenants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Tenant"
+                    }
+                }
+            }
+        },
+        "model.TextMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 }
             }
@@ -366,16 +2520,275 @@ var doc = `{
                 }
             }
         },
+        "model.TwoStepRequest": {
+            "type": "object",
+            "properties": {
+                "pin": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.User": {
             "type": "object",
             "properties": {
                 "password": {
                     "type": "string"
                 },
+                "role": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UserInfo": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "password_change_required": {
+                    "type": "boolean"
+                },
+                "password_changed": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "integer"
+                },
                 "username": {
                     "type": "string"
                 }
             }
+        },
+        "model.UserList": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UserInfo"
+                    }
+                }
+            }
+        },
+        "model.UserRecord": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "passwordChangeRequired": {
+                    "description": "the password has to be changed on the next login",
+                    "type": "boolean"
+                },
+                "passwordChanged": {
+                    "type": "integer"
+                },
+                "passwordHash": {
+                    "description": "bcrypt hash of the password",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "role": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.VideoMessage": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/model.Provider"
+                },
+                "sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.VoiceMessage": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookChaos": {
+            "type": "object",
+            "properties": {
+                "dropProbability": {
+                    "description": "probability that a webhook request is dropped without being sent",
+                    "type": "number"
+                },
+                "duplicateProbability": {
+                    "description": "probability that a webhook request is sent twice",
+                    "type": "number"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "maxLatencyMs": {
+                    "type": "integer"
+                },
+                "minLatencyMs": {
+                    "description": "random latency that is added before a webhook request is sent",
+                    "type": "integer"
+                },
+                "reorderWindow": {
+                    "description": "number of queued webhook requests which are shuffled before they are sent",
+                    "type": "integer"
+                },
+                "splitProbability": {
+                    "description": "probability that a webhook request is split into several requests",
+                    "type": "number"
+                }
+            }
+        },
+        "model.WebhookFilter": {
+            "type": "object",
+            "properties": {
+                "contacts": {
+                    "description": "wa_ids of the contacts",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "events": {
+                    "description": "allowed values are \"messages\" and \"statuses\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.WebhookRequest": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AccountEvent"
+                    }
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Contact"
+                    }
+                },
+                "errorCounter": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Error"
+                    }
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Message"
+                    }
+                },
+                "statuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Status"
+                    }
+                }
+            }
+        },
+        "model.WebhookTarget": {
+            "type": "object",
+            "properties": {
+                "ca": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "clientCert": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "clientKey": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "compress": {
+                    "type": "boolean"
+                },
+                "compressMinsize": {
+                    "type": "integer"
+                },
+                "filter": {
+                    "$ref": "#/definitions/model.WebhookFilter"
+                },
+                "insecureSkipVerify": {
+                    "type": "boolean"
+                },
+                "maxConcurrentRequests": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.isTemplateMessage_Component_Parameter_Spec": {
+            "type": "object"
         }
     },
     "securityDefinitions": {
@@ -386,6 +2799,11 @@ var doc = `{
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
+        },
+        "ControlAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     }
 }`
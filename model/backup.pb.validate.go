@@ -70,6 +70,10 @@ func (m *BackupRequest) validate(all bool) error {
 
 	// no validation rules for Data
 
+	// no validation rules for IncludeMedia
+
+	// no validation rules for IncludeProfilePhoto
+
 	if len(errors) > 0 {
 		return BackupRequestMultiError(errors)
 	}
@@ -301,6 +305,8 @@ func (m *RestoreRequest) validate(all bool) error {
 
 	// no validation rules for Data
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
 		return RestoreRequestMultiError(errors)
 	}
@@ -378,6 +384,250 @@ var _ interface {
 	ErrorName() string
 } = RestoreRequestValidationError{}
 
+// Validate checks the field values on RestoreResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreResponseMultiError, or nil if none found.
+func (m *RestoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RestoreResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RestoreResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RestoreResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Tokens
+
+	// no validation rules for Media
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return RestoreResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreResponseMultiError is an error wrapping multiple validation errors
+// returned by RestoreResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreResponseMultiError) AllErrors() []error { return m }
+
+// RestoreResponseValidationError is the validation error returned by
+// RestoreResponse.Validate if the designated constraints aren't met.
+type RestoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreResponseValidationError) ErrorName() string { return "RestoreResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreResponseValidationError{}
+
+// Validate checks the field values on BackupManifest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BackupManifest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupManifest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BackupManifestMultiError,
+// or nil if none found.
+func (m *BackupManifest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupManifest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Created
+
+	// no validation rules for ServerVersion
+
+	if len(errors) > 0 {
+		return BackupManifestMultiError(errors)
+	}
+	return nil
+}
+
+// BackupManifestMultiError is an error wrapping multiple validation errors
+// returned by BackupManifest.ValidateAll() if the designated constraints
+// aren't met.
+type BackupManifestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupManifestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupManifestMultiError) AllErrors() []error { return m }
+
+// BackupManifestValidationError is the validation error returned by
+// BackupManifest.Validate if the designated constraints aren't met.
+type BackupManifestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupManifestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupManifestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupManifestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupManifestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupManifestValidationError) ErrorName() string { return "BackupManifestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackupManifestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupManifest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupManifestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupManifestValidationError{}
+
 // Validate checks the field values on BackupResponse_SettingsData with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -481,3 +731,110 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BackupResponse_SettingsDataValidationError{}
+
+// Validate checks the field values on RestoreResponse_Change with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreResponse_Change) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreResponse_Change with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreResponse_ChangeMultiError, or nil if none found.
+func (m *RestoreResponse_Change) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreResponse_Change) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Old
+
+	// no validation rules for New
+
+	if len(errors) > 0 {
+		return RestoreResponse_ChangeMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreResponse_ChangeMultiError is an error wrapping multiple validation
+// errors returned by RestoreResponse_Change.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreResponse_ChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreResponse_ChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreResponse_ChangeMultiError) AllErrors() []error { return m }
+
+// RestoreResponse_ChangeValidationError is the validation error returned by
+// RestoreResponse_Change.Validate if the designated constraints aren't met.
+type RestoreResponse_ChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreResponse_ChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreResponse_ChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreResponse_ChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreResponse_ChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreResponse_ChangeValidationError) ErrorName() string {
+	return "RestoreResponse_ChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreResponse_ChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreResponse_Change.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreResponse_ChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreResponse_ChangeValidationError{}
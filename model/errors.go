@@ -0,0 +1,118 @@
+package model
+
+import "fmt"
+
+// ErrorCode is an entry of the error catalogue of the WhatsApp Business API.
+// See https://developers.facebook.com/docs/whatsapp/on-premises/errors
+type ErrorCode struct {
+	Code       int32
+	Title      string
+	StatusCode int
+}
+
+var (
+	// General errors
+	ErrGenericUser              = ErrorCode{1000, "Generic user error", 400}
+	ErrMessageTooLong           = ErrorCode{1001, "Message too long", 400}
+	ErrInvalidRecipientType     = ErrorCode{1002, "Invalid recipient type", 400}
+	ErrResourceAlreadyExists    = ErrorCode{1004, "Resource already exists", 409}
+	ErrAccessDenied             = ErrorCode{1005, "Access denied", 403}
+	ErrResourceNotFound         = ErrorCode{1006, "Resource not found", 404}
+	ErrRecipientBlocked         = ErrorCode{1007, "Recipient blocked to receive message", 400}
+	ErrRequiredParameterMissing = ErrorCode{1008, "Required parameter is missing", 400}
+	ErrParameterValueInvalid    = ErrorCode{1009, "Parameter value is not valid", 400}
+	ErrParameterNotRequired     = ErrorCode{1010, "Parameter not required", 400}
+	ErrServiceNotReady          = ErrorCode{1011, "Service not ready", 503}
+	ErrUserNotValid             = ErrorCode{1013, "User is not valid", 400}
+	ErrInternal                 = ErrorCode{1014, "Internal error", 500}
+	ErrTooManyRequests          = ErrorCode{1015, "Too many requests", 429}
+	ErrSystemOverloaded         = ErrorCode{1016, "System overloaded", 503}
+	ErrNotPrimaryMaster         = ErrorCode{1017, "Not Primary Master", 421}
+	ErrNotPrimaryCoreapp        = ErrorCode{1018, "Not Primary Coreapp", 421}
+	ErrBadUser                  = ErrorCode{1021, "Bad user", 400}
+	ErrWebhooksURLNotConfigured = ErrorCode{1022, "Webhooks URL is not configured", 400}
+	ErrDatabase                 = ErrorCode{1023, "Database error occurred", 500}
+	ErrInvalidRequest           = ErrorCode{1025, "Invalid Request", 400}
+	ErrReceiverIncapable        = ErrorCode{1026, "Receiver Incapable", 400}
+	ErrAccountLocked            = ErrorCode{1031, "Account has been locked", 403}
+
+	// Message delivery errors
+	ErrReengagementMessage      = ErrorCode{470, "Message failed to send because more than 24 hours have passed since the customer last replied to this number", 400}
+	ErrSpamRateLimit            = ErrorCode{471, "Spam Rate limit hit", 400}
+	ErrIdentityChanged          = ErrorCode{480, "Identity changed", 400}
+	ErrCloudReengagementMessage = ErrorCode{131047, "Re-engagement message", 400}
+
+	// Template errors
+	ErrTemplateParamCount        = ErrorCode{2000, "Template Param Count Mismatch", 400}
+	ErrTemplateMissing           = ErrorCode{2001, "Template Missing", 404}
+	ErrTemplateFetchFailed       = ErrorCode{2002, "Template Fetch Failed", 500}
+	ErrTemplatePackMissing       = ErrorCode{2003, "Template Pack Missing", 404}
+	ErrTemplateParamTooLong      = ErrorCode{2004, "Template Param Length Too Long", 400}
+	ErrTemplateHydratedTooLong   = ErrorCode{2005, "Template Hydrated Text Too Long", 400}
+	ErrTemplateWhiteSpacePolicy  = ErrorCode{2006, "Template White Space Policy Violated", 400}
+	ErrTemplateFormatCharPolicy  = ErrorCode{2007, "Template Format Character Policy Violated", 400}
+	ErrTemplateMediaFormat       = ErrorCode{2008, "Template Media Format Unsupported", 400}
+	ErrTemplateComponentMissing  = ErrorCode{2009, "Template Required Component Missing", 400}
+	ErrTemplateInvalidURL        = ErrorCode{2010, "Template Invalid Hydrated URL", 400}
+	ErrTemplateInvalidPhone      = ErrorCode{2011, "Template Invalid Phone Number", 400}
+	ErrTemplateParamFormat       = ErrorCode{2012, "Template Parameter Format Mismatch", 400}
+	ErrTemplateButtonUnsupported = ErrorCode{2013, "Template Buttons Unsupported", 400}
+	ErrTemplateNamespaceEmpty    = ErrorCode{2014, "Expected Namespace is Empty", 400}
+	ErrInvalidNumberOfSections   = ErrorCode{2015, "Invalid Number of Sections", 400}
+	ErrInvalidNumberOfRows       = ErrorCode{2016, "Invalid Number of Rows", 400}
+	ErrCharacterPolicyViolated   = ErrorCode{2017, "Character Policy Violated", 400}
+)
+
+var catalogue = []ErrorCode{
+	ErrGenericUser, ErrMessageTooLong, ErrInvalidRecipientType, ErrResourceAlreadyExists, ErrAccessDenied,
+	ErrResourceNotFound, ErrRecipientBlocked, ErrRequiredParameterMissing, ErrParameterValueInvalid,
+	ErrParameterNotRequired, ErrServiceNotReady, ErrUserNotValid, ErrInternal, ErrTooManyRequests,
+	ErrSystemOverloaded, ErrNotPrimaryMaster, ErrNotPrimaryCoreapp, ErrBadUser, ErrWebhooksURLNotConfigured,
+	ErrDatabase, ErrInvalidRequest, ErrReceiverIncapable, ErrAccountLocked,
+	ErrReengagementMessage, ErrSpamRateLimit, ErrIdentityChanged, ErrCloudReengagementMessage,
+	ErrTemplateParamCount, ErrTemplateMissing, ErrTemplateFetchFailed, ErrTemplatePackMissing,
+	ErrTemplateParamTooLong, ErrTemplateHydratedTooLong, ErrTemplateWhiteSpacePolicy, ErrTemplateFormatCharPolicy,
+	ErrTemplateMediaFormat, ErrTemplateComponentMissing, ErrTemplateInvalidURL, ErrTemplateInvalidPhone,
+	ErrTemplateParamFormat, ErrTemplateButtonUnsupported, ErrTemplateNamespaceEmpty, ErrInvalidNumberOfSections,
+	ErrInvalidNumberOfRows, ErrCharacterPolicyViolated,
+}
+
+// LookupErrorCode returns the catalogue entry with the given code
+func LookupErrorCode(code int32) (ErrorCode, bool) {
+	for _, e := range catalogue {
+		if e.Code == code {
+			return e, true
+		}
+	}
+	return ErrorCode{}, false
+}
+
+// New returns the catalogue entry as an error of the response body
+func (e ErrorCode) New(details string) Error {
+	return Error{
+		Code:    e.Code,
+		Title:   e.Title,
+		Details: details,
+	}
+}
+
+// Newf returns the catalogue entry as an error of the response body with formatted details
+func (e ErrorCode) Newf(format string, a ...interface{}) Error {
+	return e.New(fmt.Sprintf(format, a...))
+}
+
+// APIError is an error of the catalogue which can be returned by functions
+// and is converted into the error response by the API
+type APIError struct {
+	Code    ErrorCode
+	Details string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Code.Code, e.Code.Title, e.Details)
+}
+
+// Err returns the catalogue entry as APIError
+func (e ErrorCode) Err(details string) error {
+	return &APIError{Code: e, Details: details}
+}
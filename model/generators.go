@@ -256,17 +256,34 @@ func (g *Generators) GenerateDocumentMessage() *Message {
 	return msg
 }
 
-func (g *Generators) GenerateSatiForMessage(msg *Message) []*Status {
+// GenerateSatiForMessage generates the stati of an outbound message.
+// The sent status is only generated if sentStatus is true.
+func (g *Generators) GenerateSatiForMessage(msg *Message, sentStatus bool) []*Status {
 	stati := []*Status{}
+	if sentStatus {
+		stati = append(stati, g.generateStatus(msg.To, msg.Id, "sent"))
+	}
 	stati = append(
 		stati,
-		g.generateStatus(msg.To, msg.Id, "sent"),
 		g.generateStatus(msg.To, msg.Id, "delivered"),
 		g.generateStatus(msg.To, msg.Id, "read"),
 	)
 	return stati
 }
 
+// GenerateFailedSatiForMessage generates the stati of an outbound message which could not be delivered.
+// The sent status is only generated if sentStatus is true.
+func (g *Generators) GenerateFailedSatiForMessage(msg *Message, sentStatus bool) []*Status {
+	stati := []*Status{}
+	if sentStatus {
+		stati = append(stati, g.generateStatus(msg.To, msg.Id, "sent"))
+	}
+	failed := g.generateStatus(msg.To, msg.Id, "failed")
+	reason := ErrReceiverIncapable.New("The message could not be delivered to the recipient")
+	failed.Errors = append(failed.Errors, &reason)
+	return append(stati, failed)
+}
+
 func (g *Generators) generateStatus(recipient string, msgID string, status string) *Status {
 	stat := AcquireStatus()
 	stat.Reset()
@@ -297,19 +297,3966 @@ func (m *InternalConfig) validate(all bool) error {
 
 	// no validation rules for WebhookCA
 
+	for idx, item := range m.GetWebhookTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalConfigValidationError{
+						field:  fmt.Sprintf("WebhookTargets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalConfigValidationError{
+						field:  fmt.Sprintf("WebhookTargets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalConfigValidationError{
+					field:  fmt.Sprintf("WebhookTargets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebhookChaos()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "WebhookChaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "WebhookChaos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhookChaos()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConfigValidationError{
+				field:  "WebhookChaos",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WebhookClientCert
+
+	// no validation rules for WebhookClientKey
+
+	// no validation rules for MessagingTier
+
+	if all {
+		switch v := interface{}(m.GetQualitySignals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "QualitySignals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "QualitySignals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQualitySignals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConfigValidationError{
+				field:  "QualitySignals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TwoStepPinHash
+
+	if all {
+		switch v := interface{}(m.GetRegistration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "Registration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConfigValidationError{
+					field:  "Registration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConfigValidationError{
+				field:  "Registration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalConfigValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalConfigValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalConfigValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetUserRecords()))
+		i := 0
+		for key := range m.GetUserRecords() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetUserRecords()[key]
+			_ = val
+
+			// no validation rules for UserRecords[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InternalConfigValidationError{
+							field:  fmt.Sprintf("UserRecords[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InternalConfigValidationError{
+							field:  fmt.Sprintf("UserRecords[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InternalConfigValidationError{
+						field:  fmt.Sprintf("UserRecords[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalConfigMultiError(errors)
+	}
+	return nil
+}
+
+// InternalConfigMultiError is an error wrapping multiple validation errors
+// returned by InternalConfig.ValidateAll() if the designated constraints
+// aren't met.
+type InternalConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalConfigMultiError) AllErrors() []error { return m }
+
+// InternalConfigValidationError is the validation error returned by
+// InternalConfig.Validate if the designated constraints aren't met.
+type InternalConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalConfigValidationError) ErrorName() string { return "InternalConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalConfigValidationError{}
+
+// Validate checks the field values on UserRecord with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserRecordMultiError, or
+// nil if none found.
+func (m *UserRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasswordHash
+
+	// no validation rules for Role
+
+	// no validation rules for PasswordChangeRequired
+
+	// no validation rules for Created
+
+	// no validation rules for PasswordChanged
+
+	if len(errors) > 0 {
+		return UserRecordMultiError(errors)
+	}
+	return nil
+}
+
+// UserRecordMultiError is an error wrapping multiple validation errors
+// returned by UserRecord.ValidateAll() if the designated constraints aren't met.
+type UserRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserRecordMultiError) AllErrors() []error { return m }
+
+// UserRecordValidationError is the validation error returned by
+// UserRecord.Validate if the designated constraints aren't met.
+type UserRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserRecordValidationError) ErrorName() string { return "UserRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserRecordValidationError{}
+
+// Validate checks the field values on WebhookTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookTargetMultiError, or
+// nil if none found.
+func (m *WebhookTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Url
+
+	// no validation rules for Ca
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for Compress
+
+	// no validation rules for CompressMinsize
+
+	// no validation rules for MaxConcurrentRequests
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookTargetValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookTargetValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookTargetValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientCert
+
+	// no validation rules for ClientKey
+
+	if len(errors) > 0 {
+		return WebhookTargetMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookTargetMultiError is an error wrapping multiple validation errors
+// returned by WebhookTarget.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookTargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookTargetMultiError) AllErrors() []error { return m }
+
+// WebhookTargetValidationError is the validation error returned by
+// WebhookTarget.Validate if the designated constraints aren't met.
+type WebhookTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookTargetValidationError) ErrorName() string { return "WebhookTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookTargetValidationError{}
+
+// Validate checks the field values on WebhookFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookFilterMultiError, or
+// nil if none found.
+func (m *WebhookFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WebhookFilterMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookFilterMultiError is an error wrapping multiple validation errors
+// returned by WebhookFilter.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookFilterMultiError) AllErrors() []error { return m }
+
+// WebhookFilterValidationError is the validation error returned by
+// WebhookFilter.Validate if the designated constraints aren't met.
+type WebhookFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookFilterValidationError) ErrorName() string { return "WebhookFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookFilterValidationError{}
+
+// Validate checks the field values on WebhookRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookRequestMultiError,
+// or nil if none found.
+func (m *WebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Contacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Contacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookRequestValidationError{
+					field:  fmt.Sprintf("Contacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookRequestValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookRequestValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookRequestValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErrorCounter
+
+	for idx, item := range m.GetAccount() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookRequestValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookRequestValidationError{
+					field:  fmt.Sprintf("Account[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebhookRequestMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookRequestMultiError is an error wrapping multiple validation errors
+// returned by WebhookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookRequestMultiError) AllErrors() []error { return m }
+
+// WebhookRequestValidationError is the validation error returned by
+// WebhookRequest.Validate if the designated constraints aren't met.
+type WebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookRequestValidationError) ErrorName() string { return "WebhookRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookRequestValidationError{}
+
+// Validate checks the field values on WebhookChaos with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookChaos) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookChaos with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookChaosMultiError, or
+// nil if none found.
+func (m *WebhookChaos) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookChaos) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	if val := m.GetDuplicateProbability(); val < 0 || val > 1 {
+		err := WebhookChaosValidationError{
+			field:  "DuplicateProbability",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetReorderWindow(); val < 0 || val > 1000 {
+		err := WebhookChaosValidationError{
+			field:  "ReorderWindow",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinLatencyMs() < 0 {
+		err := WebhookChaosValidationError{
+			field:  "MinLatencyMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxLatencyMs() < 0 {
+		err := WebhookChaosValidationError{
+			field:  "MaxLatencyMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSplitProbability(); val < 0 || val > 1 {
+		err := WebhookChaosValidationError{
+			field:  "SplitProbability",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDropProbability(); val < 0 || val > 1 {
+		err := WebhookChaosValidationError{
+			field:  "DropProbability",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WebhookChaosMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookChaosMultiError is an error wrapping multiple validation errors
+// returned by WebhookChaos.ValidateAll() if the designated constraints aren't met.
+type WebhookChaosMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookChaosMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookChaosMultiError) AllErrors() []error { return m }
+
+// WebhookChaosValidationError is the validation error returned by
+// WebhookChaos.Validate if the designated constraints aren't met.
+type WebhookChaosValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookChaosValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookChaosValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookChaosValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookChaosValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookChaosValidationError) ErrorName() string { return "WebhookChaosValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookChaosValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookChaos.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookChaosValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookChaosValidationError{}
+
+// Validate checks the field values on FaultRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FaultRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FaultRuleMultiError, or nil
+// if none found.
+func (m *FaultRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for Path
+
+	// no validation rules for Header
+
+	// no validation rules for HeaderPattern
+
+	// no validation rules for Body
+
+	if val := m.GetStatusCode(); val < 0 || val > 599 {
+		err := FaultRuleValidationError{
+			field:  "StatusCode",
+			reason: "value must be inside range [0, 599]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorDetails
+
+	if val := m.GetLatencyMs(); val < 0 || val > 60000 {
+		err := FaultRuleValidationError{
+			field:  "LatencyMs",
+			reason: "value must be inside range [0, 60000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DropConnection
+
+	if val := m.GetProbability(); val < 0 || val > 1 {
+		err := FaultRuleValidationError{
+			field:  "Probability",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() < 0 {
+		err := FaultRuleValidationError{
+			field:  "Count",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Hits
+
+	if len(errors) > 0 {
+		return FaultRuleMultiError(errors)
+	}
+	return nil
+}
+
+// FaultRuleMultiError is an error wrapping multiple validation errors returned
+// by FaultRule.ValidateAll() if the designated constraints aren't met.
+type FaultRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultRuleMultiError) AllErrors() []error { return m }
+
+// FaultRuleValidationError is the validation error returned by
+// FaultRule.Validate if the designated constraints aren't met.
+type FaultRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultRuleValidationError) ErrorName() string { return "FaultRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FaultRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultRuleValidationError{}
+
+// Validate checks the field values on FaultRules with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FaultRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultRules with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FaultRulesMultiError, or
+// nil if none found.
+func (m *FaultRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FaultRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FaultRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FaultRulesValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FaultRulesMultiError(errors)
+	}
+	return nil
+}
+
+// FaultRulesMultiError is an error wrapping multiple validation errors
+// returned by FaultRules.ValidateAll() if the designated constraints aren't met.
+type FaultRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultRulesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultRulesMultiError) AllErrors() []error { return m }
+
+// FaultRulesValidationError is the validation error returned by
+// FaultRules.Validate if the designated constraints aren't met.
+type FaultRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultRulesValidationError) ErrorName() string { return "FaultRulesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FaultRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultRulesValidationError{}
+
+// Validate checks the field values on MessagingUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessagingUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessagingUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessagingUsageMultiError,
+// or nil if none found.
+func (m *MessagingUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessagingUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := MessagingTier_name[int32(m.GetTier())]; !ok {
+		err := MessagingUsageValidationError{
+			field:  "Tier",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for UniqueRecipients
+
+	// no validation rules for Remaining
+
+	// no validation rules for WindowSeconds
+
+	if len(errors) > 0 {
+		return MessagingUsageMultiError(errors)
+	}
+	return nil
+}
+
+// MessagingUsageMultiError is an error wrapping multiple validation errors
+// returned by MessagingUsage.ValidateAll() if the designated constraints
+// aren't met.
+type MessagingUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessagingUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessagingUsageMultiError) AllErrors() []error { return m }
+
+// MessagingUsageValidationError is the validation error returned by
+// MessagingUsage.Validate if the designated constraints aren't met.
+type MessagingUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessagingUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessagingUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessagingUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessagingUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessagingUsageValidationError) ErrorName() string { return "MessagingUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessagingUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessagingUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessagingUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessagingUsageValidationError{}
+
+// Validate checks the field values on QualitySignals with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QualitySignals) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QualitySignals with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QualitySignalsMultiError,
+// or nil if none found.
+func (m *QualitySignals) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QualitySignals) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetBlockRate(); val < 0 || val > 1 {
+		err := QualitySignalsValidationError{
+			field:  "BlockRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetFailureRate(); val < 0 || val > 1 {
+		err := QualitySignalsValidationError{
+			field:  "FailureRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWindow(); val < 0 || val > 100000 {
+		err := QualitySignalsValidationError{
+			field:  "Window",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetYellowThreshold(); val < 0 || val > 1 {
+		err := QualitySignalsValidationError{
+			field:  "YellowThreshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRedThreshold(); val < 0 || val > 1 {
+		err := QualitySignalsValidationError{
+			field:  "RedThreshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Override
+
+	if _, ok := QualityRating_name[int32(m.GetOverrideRating())]; !ok {
+		err := QualitySignalsValidationError{
+			field:  "OverrideRating",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QualitySignalsMultiError(errors)
+	}
+	return nil
+}
+
+// QualitySignalsMultiError is an error wrapping multiple validation errors
+// returned by QualitySignals.ValidateAll() if the designated constraints
+// aren't met.
+type QualitySignalsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QualitySignalsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QualitySignalsMultiError) AllErrors() []error { return m }
+
+// QualitySignalsValidationError is the validation error returned by
+// QualitySignals.Validate if the designated constraints aren't met.
+type QualitySignalsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QualitySignalsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QualitySignalsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QualitySignalsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QualitySignalsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QualitySignalsValidationError) ErrorName() string { return "QualitySignalsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QualitySignalsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQualitySignals.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QualitySignalsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QualitySignalsValidationError{}
+
+// Validate checks the field values on Quality with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Quality) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Quality with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QualityMultiError, or nil if none found.
+func (m *Quality) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Quality) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rating
+
+	// no validation rules for Flagged
+
+	// no validation rules for Tier
+
+	// no validation rules for Score
+
+	// no validation rules for Evaluated
+
+	if all {
+		switch v := interface{}(m.GetSignals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QualityValidationError{
+					field:  "Signals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QualityValidationError{
+					field:  "Signals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QualityValidationError{
+				field:  "Signals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QualityMultiError(errors)
+	}
+	return nil
+}
+
+// QualityMultiError is an error wrapping multiple validation errors returned
+// by Quality.ValidateAll() if the designated constraints aren't met.
+type QualityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QualityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QualityMultiError) AllErrors() []error { return m }
+
+// QualityValidationError is the validation error returned by Quality.Validate
+// if the designated constraints aren't met.
+type QualityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QualityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QualityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QualityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QualityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QualityValidationError) ErrorName() string { return "QualityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QualityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QualityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QualityValidationError{}
+
+// Validate checks the field values on AccountEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccountEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccountEventMultiError, or
+// nil if none found.
+func (m *AccountEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Event
+
+	// no validation rules for QualityRating
+
+	// no validation rules for PreviousQualityRating
+
+	// no validation rules for CurrentLimit
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return AccountEventMultiError(errors)
+	}
+	return nil
+}
+
+// AccountEventMultiError is an error wrapping multiple validation errors
+// returned by AccountEvent.ValidateAll() if the designated constraints aren't met.
+type AccountEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountEventMultiError) AllErrors() []error { return m }
+
+// AccountEventValidationError is the validation error returned by
+// AccountEvent.Validate if the designated constraints aren't met.
+type AccountEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountEventValidationError) ErrorName() string { return "AccountEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountEventValidationError{}
+
+// Validate checks the field values on AppStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppStatsMultiError, or nil
+// if none found.
+func (m *AppStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InboundMessages
+
+	// no validation rules for OutboundMessages
+
+	// no validation rules for Statuses
+
+	// no validation rules for CallbackQueueSize
+
+	{
+		sorted_keys := make([]string, len(m.GetCallbacks()))
+		i := 0
+		for key := range m.GetCallbacks() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCallbacks()[key]
+			_ = val
+
+			// no validation rules for Callbacks[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, AppStatsValidationError{
+							field:  fmt.Sprintf("Callbacks[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, AppStatsValidationError{
+							field:  fmt.Sprintf("Callbacks[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return AppStatsValidationError{
+						field:  fmt.Sprintf("Callbacks[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return AppStatsMultiError(errors)
+	}
+	return nil
+}
+
+// AppStatsMultiError is an error wrapping multiple validation errors returned
+// by AppStats.ValidateAll() if the designated constraints aren't met.
+type AppStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppStatsMultiError) AllErrors() []error { return m }
+
+// AppStatsValidationError is the validation error returned by
+// AppStats.Validate if the designated constraints aren't met.
+type AppStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppStatsValidationError) ErrorName() string { return "AppStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppStatsValidationError{}
+
+// Validate checks the field values on CallbackStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CallbackStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CallbackStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CallbackStatsMultiError, or
+// nil if none found.
+func (m *CallbackStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CallbackStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sent
+
+	// no validation rules for Failed
+
+	// no validation rules for AvgLatencySeconds
+
+	if len(errors) > 0 {
+		return CallbackStatsMultiError(errors)
+	}
+	return nil
+}
+
+// CallbackStatsMultiError is an error wrapping multiple validation errors
+// returned by CallbackStats.ValidateAll() if the designated constraints
+// aren't met.
+type CallbackStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CallbackStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CallbackStatsMultiError) AllErrors() []error { return m }
+
+// CallbackStatsValidationError is the validation error returned by
+// CallbackStats.Validate if the designated constraints aren't met.
+type CallbackStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CallbackStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CallbackStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CallbackStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CallbackStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CallbackStatsValidationError) ErrorName() string { return "CallbackStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CallbackStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCallbackStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CallbackStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CallbackStatsValidationError{}
+
+// Validate checks the field values on DBStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DBStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DBStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DBStatsMultiError, or nil if none found.
+func (m *DBStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DBStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoredMessages
+
+	// no validation rules for PendingStatuses
+
+	// no validation rules for Contacts
+
+	// no validation rules for Users
+
+	// no validation rules for Sessions
+
+	// no validation rules for UniqueRecipients
+
+	if len(errors) > 0 {
+		return DBStatsMultiError(errors)
+	}
+	return nil
+}
+
+// DBStatsMultiError is an error wrapping multiple validation errors returned
+// by DBStats.ValidateAll() if the designated constraints aren't met.
+type DBStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DBStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DBStatsMultiError) AllErrors() []error { return m }
+
+// DBStatsValidationError is the validation error returned by DBStats.Validate
+// if the designated constraints aren't met.
+type DBStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DBStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DBStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DBStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DBStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DBStatsValidationError) ErrorName() string { return "DBStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DBStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDBStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DBStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DBStatsValidationError{}
+
+// Validate checks the field values on Stats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stats with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StatsMultiError, or nil if none found.
+func (m *Stats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "App",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "App",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsValidationError{
+				field:  "App",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDb()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "Db",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "Db",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDb()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsValidationError{
+				field:  "Db",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatsMultiError(errors)
+	}
+	return nil
+}
+
+// StatsMultiError is an error wrapping multiple validation errors returned by
+// Stats.ValidateAll() if the designated constraints aren't met.
+type StatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsMultiError) AllErrors() []error { return m }
+
+// StatsValidationError is the validation error returned by Stats.Validate if
+// the designated constraints aren't met.
+type StatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsValidationError) ErrorName() string { return "StatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsValidationError{}
+
+// Validate checks the field values on StatsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsResponseMultiError, or
+// nil if none found.
+func (m *StatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsResponseValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsResponseValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsResponseValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// StatsResponseMultiError is an error wrapping multiple validation errors
+// returned by StatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsResponseMultiError) AllErrors() []error { return m }
+
+// StatsResponseValidationError is the validation error returned by
+// StatsResponse.Validate if the designated constraints aren't met.
+type StatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsResponseValidationError) ErrorName() string { return "StatsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsResponseValidationError{}
+
+// Validate checks the field values on GatewayConnectivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GatewayConnectivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GatewayConnectivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GatewayConnectivityMultiError, or nil if none found.
+func (m *GatewayConnectivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GatewayConnectivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GatewayStatus
+
+	// no validation rules for Nodes
+
+	if len(errors) > 0 {
+		return GatewayConnectivityMultiError(errors)
+	}
+	return nil
+}
+
+// GatewayConnectivityMultiError is an error wrapping multiple validation
+// errors returned by GatewayConnectivity.ValidateAll() if the designated
+// constraints aren't met.
+type GatewayConnectivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GatewayConnectivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GatewayConnectivityMultiError) AllErrors() []error { return m }
+
+// GatewayConnectivityValidationError is the validation error returned by
+// GatewayConnectivity.Validate if the designated constraints aren't met.
+type GatewayConnectivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectivityValidationError) ErrorName() string {
+	return "GatewayConnectivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectivityValidationError{}
+
+// Validate checks the field values on Registration with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Registration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Registration with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RegistrationMultiError, or
+// nil if none found.
+func (m *Registration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Registration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for Cc
+
+	// no validation rules for PhoneNumber
+
+	// no validation rules for Method
+
+	// no validation rules for Code
+
+	// no validation rules for CodeExpiresAt
+
+	// no validation rules for Attempts
+
+	// no validation rules for RequestedAt
+
+	for idx, item := range m.GetInbox() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistrationValidationError{
+						field:  fmt.Sprintf("Inbox[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistrationValidationError{
+						field:  fmt.Sprintf("Inbox[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistrationValidationError{
+					field:  fmt.Sprintf("Inbox[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RegistrationMultiError(errors)
+	}
+	return nil
+}
+
+// RegistrationMultiError is an error wrapping multiple validation errors
+// returned by Registration.ValidateAll() if the designated constraints aren't met.
+type RegistrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationMultiError) AllErrors() []error { return m }
+
+// RegistrationValidationError is the validation error returned by
+// Registration.Validate if the designated constraints aren't met.
+type RegistrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationValidationError) ErrorName() string { return "RegistrationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegistrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationValidationError{}
+
+// Validate checks the field values on RegistrationCode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationCode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationCodeMultiError, or nil if none found.
+func (m *RegistrationCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cc
+
+	// no validation rules for PhoneNumber
+
+	// no validation rules for Method
+
+	// no validation rules for Code
+
+	// no validation rules for SentAt
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return RegistrationCodeMultiError(errors)
+	}
+	return nil
+}
+
+// RegistrationCodeMultiError is an error wrapping multiple validation errors
+// returned by RegistrationCode.ValidateAll() if the designated constraints
+// aren't met.
+type RegistrationCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationCodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationCodeMultiError) AllErrors() []error { return m }
+
+// RegistrationCodeValidationError is the validation error returned by
+// RegistrationCode.Validate if the designated constraints aren't met.
+type RegistrationCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationCodeValidationError) ErrorName() string { return "RegistrationCodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegistrationCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationCodeValidationError{}
+
+// Validate checks the field values on RegistrationInbox with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationInbox) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationInbox with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationInboxMultiError, or nil if none found.
+func (m *RegistrationInbox) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationInbox) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistrationInboxValidationError{
+						field:  fmt.Sprintf("Codes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistrationInboxValidationError{
+						field:  fmt.Sprintf("Codes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistrationInboxValidationError{
+					field:  fmt.Sprintf("Codes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RegistrationInboxMultiError(errors)
+	}
+	return nil
+}
+
+// RegistrationInboxMultiError is an error wrapping multiple validation errors
+// returned by RegistrationInbox.ValidateAll() if the designated constraints
+// aren't met.
+type RegistrationInboxMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationInboxMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationInboxMultiError) AllErrors() []error { return m }
+
+// RegistrationInboxValidationError is the validation error returned by
+// RegistrationInbox.Validate if the designated constraints aren't met.
+type RegistrationInboxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationInboxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationInboxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationInboxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationInboxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationInboxValidationError) ErrorName() string {
+	return "RegistrationInboxValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegistrationInboxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationInbox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationInboxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationInboxValidationError{}
+
+// Validate checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tenant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TenantMultiError, or nil if none found.
+func (m *Tenant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tenant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_Tenant_Name_Pattern.MatchString(m.GetName()) {
+		err := TenantValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]{0,62}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantMultiError(errors)
+	}
+	return nil
+}
+
+// TenantMultiError is an error wrapping multiple validation errors returned by
+// Tenant.ValidateAll() if the designated constraints aren't met.
+type TenantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantMultiError) AllErrors() []error { return m }
+
+// TenantValidationError is the validation error returned by Tenant.Validate if
+// the designated constraints aren't met.
+type TenantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantValidationError) ErrorName() string { return "TenantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantValidationError{}
+
+var _Tenant_Name_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,62}$")
+
+// Validate checks the field values on Tenants with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tenants) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tenants with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TenantsMultiError, or nil if none found.
+func (m *Tenants) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tenants) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantsValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantsValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantsValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantsMultiError(errors)
+	}
+	return nil
+}
+
+// TenantsMultiError is an error wrapping multiple validation errors returned
+// by Tenants.ValidateAll() if the designated constraints aren't met.
+type TenantsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantsMultiError) AllErrors() []error { return m }
+
+// TenantsValidationError is the validation error returned by Tenants.Validate
+// if the designated constraints aren't met.
+type TenantsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantsValidationError) ErrorName() string { return "TenantsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenants.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantsValidationError{}
+
+// Validate checks the field values on TokenList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenListMultiError, or nil
+// if none found.
+func (m *TokenList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TokenListMultiError(errors)
+	}
+	return nil
+}
+
+// TokenListMultiError is an error wrapping multiple validation errors returned
+// by TokenList.ValidateAll() if the designated constraints aren't met.
+type TokenListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenListMultiError) AllErrors() []error { return m }
+
+// TokenListValidationError is the validation error returned by
+// TokenList.Validate if the designated constraints aren't met.
+type TokenListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenListValidationError) ErrorName() string { return "TokenListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenListValidationError{}
+
+// Validate checks the field values on SigningKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SigningKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SigningKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SigningKeyMultiError, or
+// nil if none found.
+func (m *SigningKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SigningKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return SigningKeyMultiError(errors)
+	}
+	return nil
+}
+
+// SigningKeyMultiError is an error wrapping multiple validation errors
+// returned by SigningKey.ValidateAll() if the designated constraints aren't met.
+type SigningKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SigningKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SigningKeyMultiError) AllErrors() []error { return m }
+
+// SigningKeyValidationError is the validation error returned by
+// SigningKey.Validate if the designated constraints aren't met.
+type SigningKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SigningKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SigningKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SigningKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SigningKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SigningKeyValidationError) ErrorName() string { return "SigningKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SigningKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSigningKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SigningKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SigningKeyValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for User
+
+	// no validation rules for Role
+
+	// no validation rules for IssuedAt
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on SessionList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionListMultiError, or
+// nil if none found.
+func (m *SessionList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SessionListValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SessionListValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SessionListValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SessionListMultiError(errors)
+	}
+	return nil
+}
+
+// SessionListMultiError is an error wrapping multiple validation errors
+// returned by SessionList.ValidateAll() if the designated constraints aren't met.
+type SessionListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionListMultiError) AllErrors() []error { return m }
+
+// SessionListValidationError is the validation error returned by
+// SessionList.Validate if the designated constraints aren't met.
+type SessionListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionListValidationError) ErrorName() string { return "SessionListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionListValidationError{}
+
+// Validate checks the field values on Lockout with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Lockout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Lockout with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LockoutMultiError, or nil if none found.
+func (m *Lockout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Lockout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Ip
+
+	// no validation rules for Failures
+
+	// no validation rules for LockedUntil
+
+	if len(errors) > 0 {
+		return LockoutMultiError(errors)
+	}
+	return nil
+}
+
+// LockoutMultiError is an error wrapping multiple validation errors returned
+// by Lockout.ValidateAll() if the designated constraints aren't met.
+type LockoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockoutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockoutMultiError) AllErrors() []error { return m }
+
+// LockoutValidationError is the validation error returned by Lockout.Validate
+// if the designated constraints aren't met.
+type LockoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockoutValidationError) ErrorName() string { return "LockoutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockoutValidationError{}
+
+// Validate checks the field values on LockoutList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LockoutList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockoutList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LockoutListMultiError, or
+// nil if none found.
+func (m *LockoutList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockoutList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLockouts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LockoutListValidationError{
+						field:  fmt.Sprintf("Lockouts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LockoutListValidationError{
+						field:  fmt.Sprintf("Lockouts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LockoutListValidationError{
+					field:  fmt.Sprintf("Lockouts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return InternalConfigMultiError(errors)
+		return LockoutListMultiError(errors)
 	}
 	return nil
 }
 
-// InternalConfigMultiError is an error wrapping multiple validation errors
-// returned by InternalConfig.ValidateAll() if the designated constraints
-// aren't met.
-type InternalConfigMultiError []error
+// LockoutListMultiError is an error wrapping multiple validation errors
+// returned by LockoutList.ValidateAll() if the designated constraints aren't met.
+type LockoutListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalConfigMultiError) Error() string {
+func (m LockoutListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -318,11 +4265,11 @@ func (m InternalConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalConfigMultiError) AllErrors() []error { return m }
+func (m LockoutListMultiError) AllErrors() []error { return m }
 
-// InternalConfigValidationError is the validation error returned by
-// InternalConfig.Validate if the designated constraints aren't met.
-type InternalConfigValidationError struct {
+// LockoutListValidationError is the validation error returned by
+// LockoutList.Validate if the designated constraints aren't met.
+type LockoutListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -330,22 +4277,22 @@ type InternalConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalConfigValidationError) Field() string { return e.field }
+func (e LockoutListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalConfigValidationError) Reason() string { return e.reason }
+func (e LockoutListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalConfigValidationError) Cause() error { return e.cause }
+func (e LockoutListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalConfigValidationError) Key() bool { return e.key }
+func (e LockoutListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalConfigValidationError) ErrorName() string { return "InternalConfigValidationError" }
+func (e LockoutListValidationError) ErrorName() string { return "LockoutListValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InternalConfigValidationError) Error() string {
+func (e LockoutListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -357,14 +4304,14 @@ func (e InternalConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalConfig.%s: %s%s",
+		"invalid %sLockoutList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalConfigValidationError{}
+var _ error = LockoutListValidationError{}
 
 var _ interface {
 	Field() string
@@ -372,149 +4319,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalConfigValidationError{}
+} = LockoutListValidationError{}
 
-// Validate checks the field values on WebhookRequest with the rules defined in
+// Validate checks the field values on MediaMetadata with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *WebhookRequest) Validate() error {
+func (m *MediaMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WebhookRequest with the rules defined
+// ValidateAll checks the field values on MediaMetadata with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WebhookRequestMultiError,
-// or nil if none found.
-func (m *WebhookRequest) ValidateAll() error {
+// result is a list of violation errors wrapped in MediaMetadataMultiError, or
+// nil if none found.
+func (m *MediaMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WebhookRequest) validate(all bool) error {
+func (m *MediaMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetContacts() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Contacts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Contacts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return WebhookRequestValidationError{
-					field:  fmt.Sprintf("Contacts[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for MimeType
+
+	// no validation rules for FileSize
+
+	// no validation rules for Sha256
+
+	// no validation rules for Created
 
+	if len(errors) > 0 {
+		return MediaMetadataMultiError(errors)
 	}
+	return nil
+}
 
-	for idx, item := range m.GetMessages() {
-		_, _ = idx, item
+// MediaMetadataMultiError is an error wrapping multiple validation errors
+// returned by MediaMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type MediaMetadataMultiError []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Messages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Messages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return WebhookRequestValidationError{
-					field:  fmt.Sprintf("Messages[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaMetadataMultiError) AllErrors() []error { return m }
+
+// MediaMetadataValidationError is the validation error returned by
+// MediaMetadata.Validate if the designated constraints aren't met.
+type MediaMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaMetadataValidationError) ErrorName() string { return "MediaMetadataValidationError" }
 
+// Error satisfies the builtin error interface
+func (e MediaMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetStatuses() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return WebhookRequestValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sMediaMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaMetadataValidationError{}
+
+// Validate checks the field values on WebhookQueue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookQueue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookQueue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookQueueMultiError, or
+// nil if none found.
+func (m *WebhookQueue) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *WebhookQueue) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetErrors() {
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Errors[%v]", idx),
+					errors = append(errors, WebhookQueueValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, WebhookRequestValidationError{
-						field:  fmt.Sprintf("Errors[%v]", idx),
+					errors = append(errors, WebhookQueueValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -522,8 +4476,8 @@ func (m *WebhookRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return WebhookRequestValidationError{
-					field:  fmt.Sprintf("Errors[%v]", idx),
+				return WebhookQueueValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -532,21 +4486,18 @@ func (m *WebhookRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for ErrorCounter
-
 	if len(errors) > 0 {
-		return WebhookRequestMultiError(errors)
+		return WebhookQueueMultiError(errors)
 	}
 	return nil
 }
 
-// WebhookRequestMultiError is an error wrapping multiple validation errors
-// returned by WebhookRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WebhookRequestMultiError []error
+// WebhookQueueMultiError is an error wrapping multiple validation errors
+// returned by WebhookQueue.ValidateAll() if the designated constraints aren't met.
+type WebhookQueueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WebhookRequestMultiError) Error() string {
+func (m WebhookQueueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -555,11 +4506,11 @@ func (m WebhookRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WebhookRequestMultiError) AllErrors() []error { return m }
+func (m WebhookQueueMultiError) AllErrors() []error { return m }
 
-// WebhookRequestValidationError is the validation error returned by
-// WebhookRequest.Validate if the designated constraints aren't met.
-type WebhookRequestValidationError struct {
+// WebhookQueueValidationError is the validation error returned by
+// WebhookQueue.Validate if the designated constraints aren't met.
+type WebhookQueueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -567,22 +4518,22 @@ type WebhookRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WebhookRequestValidationError) Field() string { return e.field }
+func (e WebhookQueueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WebhookRequestValidationError) Reason() string { return e.reason }
+func (e WebhookQueueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WebhookRequestValidationError) Cause() error { return e.cause }
+func (e WebhookQueueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WebhookRequestValidationError) Key() bool { return e.key }
+func (e WebhookQueueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WebhookRequestValidationError) ErrorName() string { return "WebhookRequestValidationError" }
+func (e WebhookQueueValidationError) ErrorName() string { return "WebhookQueueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WebhookRequestValidationError) Error() string {
+func (e WebhookQueueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -594,14 +4545,14 @@ func (e WebhookRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWebhookRequest.%s: %s%s",
+		"invalid %sWebhookQueue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WebhookRequestValidationError{}
+var _ error = WebhookQueueValidationError{}
 
 var _ interface {
 	Field() string
@@ -609,4 +4560,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WebhookRequestValidationError{}
+} = WebhookQueueValidationError{}
@@ -177,6 +177,53 @@ func (m *VerifyRequest) GetCode() string {
 	return ""
 }
 
+type TwoStepRequest struct {
+	Pin                  string   `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TwoStepRequest) Reset()         { *m = TwoStepRequest{} }
+func (m *TwoStepRequest) String() string { return proto.CompactTextString(m) }
+func (*TwoStepRequest) ProtoMessage()    {}
+func (*TwoStepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c7cab62fa432213, []int{2}
+}
+func (m *TwoStepRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwoStepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwoStepRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwoStepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwoStepRequest.Merge(m, src)
+}
+func (m *TwoStepRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwoStepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwoStepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwoStepRequest proto.InternalMessageInfo
+
+func (m *TwoStepRequest) GetPin() string {
+	if m != nil {
+		return m.Pin
+	}
+	return ""
+}
+
 type ApplicationSettings struct {
 	CallbackBackoffDelayMs    int32                         `protobuf:"varint,1,opt,name=callback_backoff_delay_ms,json=callbackBackoffDelayMs,proto3" json:"callback_backoff_delay_ms,omitempty"`
 	MaxCallbackBackoffDelayMs int32                         `protobuf:"varint,2,opt,name=max_callback_backoff_delay_ms,json=maxCallbackBackoffDelayMs,proto3" json:"max_callback_backoff_delay_ms,omitempty"`
@@ -197,7 +244,7 @@ func (m *ApplicationSettings) Reset()         { *m = ApplicationSettings{} }
 func (m *ApplicationSettings) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSettings) ProtoMessage()    {}
 func (*ApplicationSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{2}
+	return fileDescriptor_6c7cab62fa432213, []int{3}
 }
 func (m *ApplicationSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +354,7 @@ func (m *ApplicationSettings_Media) Reset()         { *m = ApplicationSettings_M
 func (m *ApplicationSettings_Media) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSettings_Media) ProtoMessage()    {}
 func (*ApplicationSettings_Media) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{2, 0}
+	return fileDescriptor_6c7cab62fa432213, []int{3, 0}
 }
 func (m *ApplicationSettings_Media) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +402,7 @@ func (m *ApplicationSettings_Webhooks) Reset()         { *m = ApplicationSetting
 func (m *ApplicationSettings_Webhooks) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSettings_Webhooks) ProtoMessage()    {}
 func (*ApplicationSettings_Webhooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{2, 1}
+	return fileDescriptor_6c7cab62fa432213, []int{3, 1}
 }
 func (m *ApplicationSettings_Webhooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +456,7 @@ func (m *ProfileAbout) Reset()         { *m = ProfileAbout{} }
 func (m *ProfileAbout) String() string { return proto.CompactTextString(m) }
 func (*ProfileAbout) ProtoMessage()    {}
 func (*ProfileAbout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{3}
+	return fileDescriptor_6c7cab62fa432213, []int{4}
 }
 func (m *ProfileAbout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +507,7 @@ func (m *BusinessProfile) Reset()         { *m = BusinessProfile{} }
 func (m *BusinessProfile) String() string { return proto.CompactTextString(m) }
 func (*BusinessProfile) ProtoMessage()    {}
 func (*BusinessProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c7cab62fa432213, []int{4}
+	return fileDescriptor_6c7cab62fa432213, []int{5}
 }
 func (m *BusinessProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,6 +575,7 @@ func init() {
 	proto.RegisterEnum("whatsapp.RegistrationRequest_ContactMethod", RegistrationRequest_ContactMethod_name, RegistrationRequest_ContactMethod_value)
 	proto.RegisterType((*RegistrationRequest)(nil), "whatsapp.RegistrationRequest")
 	proto.RegisterType((*VerifyRequest)(nil), "whatsapp.VerifyRequest")
+	proto.RegisterType((*TwoStepRequest)(nil), "whatsapp.TwoStepRequest")
 	proto.RegisterType((*ApplicationSettings)(nil), "whatsapp.ApplicationSettings")
 	proto.RegisterType((*ApplicationSettings_Media)(nil), "whatsapp.ApplicationSettings.Media")
 	proto.RegisterType((*ApplicationSettings_Webhooks)(nil), "whatsapp.ApplicationSettings.Webhooks")
@@ -538,53 +586,56 @@ func init() {
 func init() { proto.RegisterFile("settings.proto", fileDescriptor_6c7cab62fa432213) }
 
 var fileDescriptor_6c7cab62fa432213 = []byte{
-	// 732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x5d, 0x6f, 0xe3, 0x44,
-	0x14, 0xc5, 0xc9, 0xe6, 0xeb, 0xa6, 0xed, 0x46, 0xb3, 0xcb, 0xe2, 0x06, 0x51, 0x42, 0x2a, 0xa1,
-	0x22, 0xd8, 0x04, 0x15, 0x09, 0x69, 0x25, 0x84, 0x68, 0x52, 0xc4, 0x53, 0xab, 0xca, 0x2d, 0x20,
-	0xf1, 0x62, 0x8d, 0xc7, 0x37, 0xf6, 0xa8, 0xf6, 0x8c, 0x99, 0x19, 0xe7, 0xe3, 0xb7, 0xf0, 0x5b,
-	0x78, 0xe7, 0x91, 0x9f, 0x80, 0x2a, 0xf1, 0x3f, 0xd0, 0x8c, 0xed, 0x50, 0xa0, 0xec, 0x4b, 0x74,
-	0xe7, 0xdc, 0x73, 0xae, 0xe7, 0x1e, 0x1f, 0x07, 0x8e, 0x34, 0x1a, 0xc3, 0x45, 0xa2, 0x67, 0x85,
-	0x92, 0x46, 0x92, 0xfe, 0x26, 0xa5, 0x46, 0xd3, 0xa2, 0x18, 0x5f, 0x24, 0xdc, 0xa4, 0x65, 0x34,
-	0x63, 0x32, 0x9f, 0xa3, 0x58, 0xcb, 0x5d, 0xa1, 0xe4, 0x76, 0x37, 0x77, 0x34, 0xf6, 0x3a, 0x41,
-	0xf1, 0x7a, 0x4d, 0x33, 0x1e, 0x53, 0x83, 0xf3, 0xff, 0x14, 0xd5, 0xb0, 0xe9, 0x9f, 0x1e, 0xbc,
-	0x08, 0x30, 0xe1, 0xda, 0x28, 0x6a, 0xb8, 0x14, 0x01, 0xfe, 0x5c, 0xa2, 0x36, 0xe4, 0x08, 0x5a,
-	0x8c, 0xf9, 0xde, 0xc4, 0x3b, 0x1b, 0x04, 0x2d, 0xc6, 0xc8, 0x47, 0x70, 0x50, 0xa4, 0x52, 0x60,
-	0x28, 0xca, 0x3c, 0x42, 0xe5, 0xb7, 0x5c, 0x67, 0xe8, 0xb0, 0x6b, 0x07, 0x91, 0x25, 0x74, 0x73,
-	0x34, 0xa9, 0x8c, 0xfd, 0xf6, 0xc4, 0x3b, 0x3b, 0x3a, 0xff, 0x74, 0xd6, 0x5c, 0x74, 0xf6, 0xc4,
-	0x13, 0x66, 0x4b, 0x29, 0x0c, 0x65, 0xe6, 0xca, 0x49, 0x82, 0x5a, 0x4a, 0x08, 0x3c, 0x63, 0xa8,
-	0x8c, 0xff, 0xcc, 0xcd, 0x77, 0x35, 0x19, 0x41, 0xbb, 0xe0, 0xc2, 0xef, 0x38, 0xc8, 0x96, 0xd3,
-	0xcf, 0xe1, 0xf0, 0x1f, 0x72, 0x32, 0x84, 0x5e, 0x29, 0xee, 0x85, 0xdc, 0x88, 0xd1, 0x3b, 0xa4,
-	0x07, 0x6d, 0x9d, 0xeb, 0x91, 0x47, 0x06, 0xd0, 0x59, 0x4b, 0xce, 0x70, 0xd4, 0x9a, 0x9e, 0xc2,
-	0xe1, 0x0f, 0xa8, 0xf8, 0x6a, 0xd7, 0x2c, 0x68, 0x1f, 0x24, 0x63, 0xac, 0x57, 0x74, 0xf5, 0xf4,
-	0xd7, 0x0e, 0xbc, 0xb8, 0x28, 0x8a, 0x8c, 0x33, 0x77, 0xd3, 0xdb, 0xda, 0x77, 0xf2, 0x06, 0x8e,
-	0x19, 0xcd, 0xb2, 0x88, 0xb2, 0xfb, 0xd0, 0xfe, 0xc8, 0xd5, 0x2a, 0x8c, 0x31, 0xa3, 0xbb, 0x30,
-	0xd7, 0x6e, 0x40, 0x27, 0x78, 0xd5, 0x10, 0x16, 0x55, 0xff, 0xd2, 0xb6, 0xaf, 0x34, 0xf9, 0x06,
-	0x3e, 0xc8, 0xe9, 0x36, 0xfc, 0x7f, 0x79, 0xcb, 0xc9, 0x8f, 0x73, 0xba, 0x5d, 0x3e, 0x3d, 0xe1,
-	0x13, 0x18, 0xed, 0xd5, 0x05, 0x2a, 0xcd, 0xb5, 0x71, 0x06, 0xf7, 0x83, 0xe7, 0x0d, 0x7e, 0x53,
-	0xc1, 0xe4, 0x0d, 0x74, 0x72, 0x8c, 0x39, 0x75, 0xee, 0x0d, 0xcf, 0x4f, 0xff, 0x7e, 0x01, 0x4f,
-	0x6c, 0x35, 0xbb, 0xb2, 0xd4, 0xa0, 0x52, 0x90, 0x05, 0xf4, 0x37, 0x18, 0xa5, 0x52, 0xde, 0x6b,
-	0x67, 0xf4, 0xf0, 0xfc, 0xe3, 0xb7, 0xab, 0x7f, 0xac, 0xd9, 0xc1, 0x5e, 0xe7, 0x32, 0x42, 0xb5,
-	0x0e, 0x4d, 0xaa, 0x64, 0x99, 0xa4, 0x7e, 0xd7, 0xdd, 0x72, 0x68, 0xb1, 0xbb, 0x0a, 0x22, 0x1f,
-	0xc2, 0x50, 0xa3, 0x30, 0xa1, 0x36, 0xd4, 0x94, 0xda, 0xef, 0x39, 0x06, 0x58, 0xe8, 0xd6, 0x21,
-	0xe4, 0x2b, 0x18, 0xc7, 0x51, 0x98, 0x50, 0x15, 0xd1, 0x04, 0x99, 0xcc, 0x32, 0x64, 0x46, 0xaa,
-	0x10, 0x05, 0x8d, 0x32, 0xf4, 0xfb, 0x8e, 0xef, 0xc7, 0xd1, 0x77, 0xff, 0x22, 0x7c, 0xeb, 0xfa,
-	0xf6, 0x45, 0x09, 0x69, 0xf8, 0x6a, 0x17, 0x96, 0x1a, 0x55, 0xc8, 0x52, 0x2a, 0x92, 0x7d, 0x64,
-	0x07, 0x4e, 0xfc, 0xaa, 0x22, 0x7c, 0xaf, 0x51, 0x2d, 0x5d, 0xbb, 0x4e, 0xef, 0xd7, 0xf0, 0xbe,
-	0x4e, 0xe5, 0x26, 0xd4, 0xc8, 0x4a, 0xc5, 0xcd, 0x2e, 0x74, 0xbc, 0x7a, 0x6b, 0xed, 0x83, 0x13,
-	0x1f, 0x5b, 0xca, 0x6d, 0xcd, 0xb8, 0x7e, 0x4c, 0x18, 0x7f, 0x06, 0x1d, 0x67, 0x28, 0x39, 0x85,
-	0x43, 0x5a, 0x1a, 0x19, 0xc6, 0x72, 0x23, 0x32, 0x49, 0x63, 0xdf, 0x9b, 0xb4, 0xcf, 0x06, 0xc1,
-	0x81, 0x05, 0x2f, 0x6b, 0x6c, 0x7c, 0x07, 0xfd, 0xc6, 0x40, 0x1b, 0xef, 0x52, 0x65, 0x75, 0x10,
-	0x6d, 0x49, 0xbe, 0x84, 0xf7, 0x5c, 0x68, 0xa4, 0x60, 0xa5, 0x52, 0xd6, 0x2f, 0x55, 0xa5, 0xb6,
-	0x89, 0xcb, 0xbb, 0x36, 0x2e, 0xfb, 0x6e, 0x1d, 0x69, 0x3d, 0x9d, 0xc2, 0xc1, 0x8d, 0x92, 0x2b,
-	0x9e, 0xe1, 0x45, 0x24, 0x4b, 0x97, 0x71, 0x83, 0x5b, 0xd3, 0x64, 0xdc, 0xd6, 0xd3, 0x5f, 0x3c,
-	0x78, 0xbe, 0x28, 0x35, 0x17, 0xa8, 0x75, 0x4d, 0x26, 0x3e, 0xf4, 0x68, 0x1c, 0x2b, 0xd4, 0xba,
-	0xa6, 0x36, 0x47, 0x32, 0x81, 0x61, 0x8c, 0x9a, 0x29, 0x5e, 0xd8, 0x2d, 0x9b, 0xaf, 0xfe, 0x11,
-	0x44, 0x5e, 0x42, 0x07, 0x73, 0xca, 0x33, 0x97, 0xc9, 0x41, 0x50, 0x1d, 0xc8, 0x18, 0xfa, 0x6b,
-	0x54, 0x86, 0x33, 0x9a, 0xd5, 0x9f, 0xf2, 0xfe, 0x6c, 0x7b, 0x1b, 0x8c, 0x34, 0x37, 0x68, 0xa3,
-	0x66, 0xbd, 0xd9, 0x9f, 0x17, 0x2f, 0x7f, 0x7b, 0x38, 0xf1, 0x7e, 0x7f, 0x38, 0xf1, 0xfe, 0x78,
-	0x38, 0xf1, 0x7e, 0xea, 0xce, 0x73, 0x19, 0x63, 0x16, 0x75, 0xdd, 0x7f, 0xd5, 0x17, 0x7f, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x91, 0x90, 0xac, 0x72, 0x0a, 0x05, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0xe9, 0xa6, 0x4d, 0x4e, 0xda, 0x6c, 0x98, 0x5d, 0x16, 0x37, 0x88, 0x52, 0x52, 0x84,
+	0x8a, 0xa0, 0xe9, 0x2a, 0x88, 0x95, 0x2a, 0x21, 0x44, 0x93, 0x45, 0x5c, 0x75, 0xb5, 0x72, 0x0a,
+	0x48, 0x20, 0xb0, 0xc6, 0xe3, 0x13, 0x7b, 0x54, 0x7b, 0xc6, 0xcc, 0x8c, 0xf3, 0x23, 0xc4, 0x93,
+	0xf0, 0x2c, 0xdc, 0x73, 0xc9, 0x23, 0xa0, 0x4a, 0xbc, 0x04, 0x57, 0x68, 0xc6, 0x76, 0x28, 0x50,
+	0xb8, 0xb1, 0xce, 0x7c, 0xe7, 0xfb, 0xc6, 0x73, 0xce, 0xf9, 0x66, 0xa0, 0xaf, 0xd1, 0x18, 0x2e,
+	0x12, 0x3d, 0x2e, 0x94, 0x34, 0x92, 0x74, 0x56, 0x29, 0x35, 0x9a, 0x16, 0xc5, 0xf0, 0x32, 0xe1,
+	0x26, 0x2d, 0xa3, 0x31, 0x93, 0xf9, 0x39, 0x8a, 0xa5, 0xdc, 0x14, 0x4a, 0xae, 0x37, 0xe7, 0x8e,
+	0xc6, 0xce, 0x12, 0x14, 0x67, 0x4b, 0x9a, 0xf1, 0x98, 0x1a, 0x3c, 0xff, 0x57, 0x50, 0x6d, 0x36,
+	0xfa, 0xdd, 0x83, 0x47, 0x01, 0x26, 0x5c, 0x1b, 0x45, 0x0d, 0x97, 0x22, 0xc0, 0xef, 0x4b, 0xd4,
+	0x86, 0xf4, 0xa1, 0xc5, 0x98, 0xef, 0x1d, 0x7b, 0xa7, 0xdd, 0xa0, 0xc5, 0x18, 0x79, 0x1b, 0xf6,
+	0x8b, 0x54, 0x0a, 0x0c, 0x45, 0x99, 0x47, 0xa8, 0xfc, 0x96, 0xcb, 0xf4, 0x1c, 0xf6, 0xc2, 0x41,
+	0x64, 0x06, 0xbb, 0x39, 0x9a, 0x54, 0xc6, 0xfe, 0xce, 0xb1, 0x77, 0xda, 0x9f, 0xbc, 0x3f, 0x6e,
+	0x0e, 0x3a, 0xbe, 0xe7, 0x0f, 0xe3, 0x99, 0x14, 0x86, 0x32, 0x73, 0xe5, 0x24, 0x41, 0x2d, 0x25,
+	0x04, 0x1e, 0x30, 0x54, 0xc6, 0x7f, 0xe0, 0xf6, 0x77, 0x31, 0x19, 0xc0, 0x4e, 0xc1, 0x85, 0xdf,
+	0x76, 0x90, 0x0d, 0x47, 0x4f, 0xe1, 0xe0, 0x6f, 0x72, 0xd2, 0x83, 0xbd, 0x52, 0xdc, 0x08, 0xb9,
+	0x12, 0x83, 0x57, 0xc8, 0x1e, 0xec, 0xe8, 0x5c, 0x0f, 0x3c, 0xd2, 0x85, 0xf6, 0x52, 0x72, 0x86,
+	0x83, 0xd6, 0xe8, 0x04, 0x0e, 0xbe, 0x44, 0xc5, 0x17, 0x9b, 0xa6, 0x40, 0xfb, 0x23, 0x19, 0x63,
+	0x5d, 0xa2, 0x8b, 0x47, 0x1f, 0x41, 0xff, 0x7a, 0x25, 0xe7, 0x06, 0x8b, 0x86, 0x75, 0x52, 0xfd,
+	0xda, 0x91, 0xa6, 0xaf, 0xfe, 0x31, 0xed, 0xab, 0xfd, 0x09, 0x7c, 0xf7, 0xcd, 0xd3, 0xb3, 0x8b,
+	0x6f, 0x7f, 0x78, 0xf6, 0xe3, 0x3b, 0xd5, 0x69, 0x7e, 0x6e, 0xc3, 0xa3, 0xcb, 0xa2, 0xc8, 0x38,
+	0x73, 0x05, 0xce, 0xeb, 0x71, 0x91, 0x0b, 0x38, 0x64, 0x34, 0xcb, 0x22, 0xca, 0x6e, 0x42, 0xfb,
+	0x91, 0x8b, 0x45, 0x18, 0x63, 0x46, 0x37, 0x61, 0xae, 0xdd, 0x96, 0xed, 0xe0, 0x49, 0x43, 0x98,
+	0x56, 0xf9, 0xe7, 0x36, 0x7d, 0xa5, 0xc9, 0xa7, 0xf0, 0x66, 0x4e, 0xd7, 0xe1, 0x7f, 0xcb, 0x5b,
+	0x4e, 0x7e, 0x98, 0xd3, 0xf5, 0xec, 0xfe, 0x1d, 0xde, 0x83, 0xc1, 0x56, 0x5d, 0xa0, 0xd2, 0x5c,
+	0x1b, 0x37, 0x97, 0x4e, 0xf0, 0xb0, 0xc1, 0x5f, 0x56, 0x30, 0xb9, 0x80, 0x76, 0x8e, 0x31, 0xa7,
+	0xae, 0xe9, 0xbd, 0xc9, 0xc9, 0x5f, 0x73, 0xbb, 0xa7, 0xaa, 0xf1, 0x95, 0xa5, 0x06, 0x95, 0x82,
+	0x4c, 0xa1, 0xb3, 0xc2, 0x28, 0x95, 0xf2, 0x46, 0xbb, 0xf9, 0xf4, 0x26, 0xef, 0xfe, 0xbf, 0xfa,
+	0xab, 0x9a, 0x1d, 0x6c, 0x75, 0xce, 0x5a, 0x54, 0xeb, 0xd0, 0xa4, 0x4a, 0x96, 0x49, 0xea, 0xef,
+	0xba, 0x53, 0xf6, 0x2c, 0x76, 0x5d, 0x41, 0xe4, 0x2d, 0xe8, 0x69, 0x14, 0x26, 0xd4, 0x86, 0x9a,
+	0x52, 0xfb, 0x7b, 0x8e, 0x01, 0x16, 0x9a, 0x3b, 0x84, 0x7c, 0x0c, 0xc3, 0x38, 0x0a, 0x13, 0xaa,
+	0x22, 0x9a, 0x20, 0x93, 0x59, 0x86, 0xcc, 0x48, 0x15, 0xa2, 0xa0, 0x51, 0x86, 0x7e, 0xc7, 0xf1,
+	0xfd, 0x38, 0xfa, 0xfc, 0x1f, 0x84, 0xcf, 0x5c, 0xde, 0x0e, 0x4a, 0x48, 0xc3, 0x17, 0x9b, 0xb0,
+	0xd4, 0xa8, 0x42, 0x96, 0x52, 0x91, 0x6c, 0x9d, 0xde, 0x75, 0xe2, 0x27, 0x15, 0xe1, 0x0b, 0x8d,
+	0x6a, 0xe6, 0xd2, 0xb5, 0xe9, 0x3f, 0x81, 0x37, 0x74, 0x2a, 0x57, 0xa1, 0x46, 0x56, 0x2a, 0x6e,
+	0x36, 0xa1, 0xe3, 0xd5, 0x55, 0x6b, 0x1f, 0x9c, 0xf8, 0xd0, 0x52, 0xe6, 0x35, 0xe3, 0xc5, 0x5d,
+	0xc2, 0xf0, 0x03, 0x68, 0xbb, 0x86, 0x92, 0x13, 0x38, 0xa0, 0xa5, 0x91, 0x61, 0x2c, 0x57, 0x22,
+	0x93, 0x34, 0xf6, 0xbd, 0xe3, 0x9d, 0xd3, 0x6e, 0xb0, 0x6f, 0xc1, 0xe7, 0x35, 0x36, 0xbc, 0x86,
+	0x4e, 0xd3, 0x40, 0x7b, 0x2b, 0x4a, 0x95, 0xd5, 0xfe, 0xb5, 0x21, 0x79, 0x06, 0xaf, 0x3b, 0xd3,
+	0x48, 0xc1, 0x4a, 0xa5, 0x6c, 0xbf, 0x54, 0x65, 0xe3, 0xc6, 0x2e, 0xaf, 0x59, 0xbb, 0x6c, 0xb3,
+	0xb5, 0xc7, 0xf5, 0x68, 0x04, 0xfb, 0x2f, 0x95, 0x5c, 0xf0, 0x0c, 0x2f, 0x23, 0x59, 0xba, 0xab,
+	0x61, 0x70, 0x6d, 0x9a, 0xab, 0x61, 0xe3, 0xd1, 0x4f, 0x1e, 0x3c, 0x9c, 0x96, 0x9a, 0x0b, 0xd4,
+	0xba, 0x26, 0x13, 0x1f, 0xf6, 0x68, 0x1c, 0x2b, 0xd4, 0xba, 0xa6, 0x36, 0x4b, 0x72, 0x0c, 0xbd,
+	0x18, 0x35, 0x53, 0xbc, 0xb0, 0x55, 0x36, 0x8f, 0xc5, 0x1d, 0x88, 0x3c, 0x86, 0x36, 0xe6, 0x94,
+	0x67, 0xce, 0x93, 0xdd, 0xa0, 0x5a, 0x90, 0x21, 0x74, 0x96, 0xa8, 0x0c, 0x67, 0x34, 0xab, 0x5f,
+	0x80, 0xed, 0xda, 0xe6, 0x56, 0x18, 0x69, 0x6e, 0xd0, 0x5a, 0xcd, 0xf6, 0x66, 0xbb, 0x9e, 0x3e,
+	0xfe, 0xe5, 0xf6, 0xc8, 0xfb, 0xf5, 0xf6, 0xc8, 0xfb, 0xed, 0xf6, 0xc8, 0xfb, 0x7a, 0xf7, 0x3c,
+	0x97, 0x31, 0x66, 0xd1, 0xae, 0x7b, 0xe2, 0x3e, 0xfc, 0x73, 0x00, 0x3f, 0x07, 0x1d, 0x30, 0x41,
+	0x05, 0x00, 0x00,
 }
 
 func (m *RegistrationRequest) Marshal() (dAtA []byte, err error) {
@@ -681,6 +732,40 @@ func (m *VerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwoStepRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwoStepRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwoStepRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pin) > 0 {
+		i -= len(m.Pin)
+		copy(dAtA[i:], m.Pin)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Pin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1033,6 +1118,22 @@ func (m *VerifyRequest) Size() (n int) {
 	return n
 }
 
+func (m *TwoStepRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pin)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1451,6 +1552,89 @@ func (m *VerifyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TwoStepRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwoStepRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwoStepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
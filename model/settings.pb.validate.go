@@ -247,6 +247,118 @@ var _ interface {
 	ErrorName() string
 } = VerifyRequestValidationError{}
 
+// Validate checks the field values on TwoStepRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TwoStepRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TwoStepRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TwoStepRequestMultiError,
+// or nil if none found.
+func (m *TwoStepRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TwoStepRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_TwoStepRequest_Pin_Pattern.MatchString(m.GetPin()) {
+		err := TwoStepRequestValidationError{
+			field:  "Pin",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TwoStepRequestMultiError(errors)
+	}
+	return nil
+}
+
+// TwoStepRequestMultiError is an error wrapping multiple validation errors
+// returned by TwoStepRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TwoStepRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TwoStepRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TwoStepRequestMultiError) AllErrors() []error { return m }
+
+// TwoStepRequestValidationError is the validation error returned by
+// TwoStepRequest.Validate if the designated constraints aren't met.
+type TwoStepRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TwoStepRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TwoStepRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TwoStepRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TwoStepRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TwoStepRequestValidationError) ErrorName() string { return "TwoStepRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TwoStepRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTwoStepRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TwoStepRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TwoStepRequestValidationError{}
+
+var _TwoStepRequest_Pin_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
 // Validate checks the field values on ApplicationSettings with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
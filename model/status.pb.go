@@ -30,6 +30,7 @@ const (
 	Status_sent      Status_StatusEnum = 1
 	Status_delivered Status_StatusEnum = 2
 	Status_read      Status_StatusEnum = 3
+	Status_failed    Status_StatusEnum = 4
 )
 
 var Status_StatusEnum_name = map[int32]string{
@@ -37,6 +38,7 @@ var Status_StatusEnum_name = map[int32]string{
 	1: "sent",
 	2: "delivered",
 	3: "read",
+	4: "failed",
 }
 
 var Status_StatusEnum_value = map[string]int32{
@@ -44,6 +46,7 @@ var Status_StatusEnum_value = map[string]int32{
 	"sent":      1,
 	"delivered": 2,
 	"read":      3,
+	"failed":    4,
 }
 
 func (x Status_StatusEnum) String() string {
@@ -83,15 +86,17 @@ func (Pricing_PricingModel) EnumDescriptor() ([]byte, []int) {
 }
 
 type Status struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               Status_StatusEnum `protobuf:"varint,2,opt,name=status,proto3,enum=whatsapp.Status_StatusEnum" json:"status,omitempty"`
-	RecipientId          string            `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	Timestamp            int64             `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Conversation         *Conversation     `protobuf:"bytes,5,opt,name=conversation,proto3" json:"conversation,omitempty"`
-	Pricing              *Pricing          `protobuf:"bytes,6,opt,name=pricing,proto3" json:"pricing,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id           string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status       Status_StatusEnum `protobuf:"varint,2,opt,name=status,proto3,enum=whatsapp.Status_StatusEnum" json:"status,omitempty"`
+	RecipientId  string            `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Timestamp    int64             `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Conversation *Conversation     `protobuf:"bytes,5,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	Pricing      *Pricing          `protobuf:"bytes,6,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	// the reason why a message failed, only set for the failed status
+	Errors               []*Error `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
@@ -169,6 +174,13 @@ func (m *Status) GetPricing() *Pricing {
 	return nil
 }
 
+func (m *Status) GetErrors() []*Error {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 type Conversation struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -282,32 +294,34 @@ func init() {
 func init() { proto.RegisterFile("status.proto", fileDescriptor_dfe4fce6682daf5b) }
 
 var fileDescriptor_dfe4fce6682daf5b = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x4d, 0x8e, 0xd3, 0x30,
-	0x18, 0xc5, 0xe9, 0x90, 0xb6, 0x5f, 0x33, 0x55, 0xb0, 0x10, 0x8a, 0x06, 0x14, 0x85, 0xac, 0x22,
-	0xa1, 0x26, 0x52, 0x67, 0x87, 0xd8, 0xd0, 0x8a, 0x05, 0x0b, 0x50, 0x15, 0x76, 0x6c, 0x46, 0x4e,
-	0x6c, 0x75, 0x2c, 0x12, 0xdb, 0x4a, 0x9c, 0x0c, 0x73, 0x14, 0x0e, 0xc2, 0x1d, 0x58, 0x72, 0x04,
-	0xd4, 0x93, 0xa0, 0x3a, 0xe9, 0x24, 0x33, 0x5d, 0x7d, 0x7f, 0xef, 0x7d, 0xef, 0xf9, 0x07, 0x9c,
-	0x5a, 0x13, 0xdd, 0xd4, 0xb1, 0xaa, 0xa4, 0x96, 0x78, 0x76, 0x77, 0x4b, 0x74, 0x4d, 0x94, 0xba,
-	0xfa, 0xb8, 0xe7, 0xfa, 0xb6, 0xc9, 0xe2, 0x5c, 0x96, 0x09, 0x13, 0xad, 0xbc, 0x57, 0x95, 0xfc,
-	0x79, 0x9f, 0x18, 0x58, 0xbe, 0xda, 0x33, 0xb1, 0x6a, 0x49, 0xc1, 0x29, 0xd1, 0x2c, 0x39, 0x4b,
-	0xba, 0x65, 0xe1, 0x6f, 0x0b, 0xec, 0x6f, 0x66, 0x3b, 0x5e, 0x82, 0xc5, 0xa9, 0x87, 0x02, 0x14,
-	0xcd, 0x53, 0x8b, 0x53, 0x7c, 0x0d, 0x76, 0xa7, 0xeb, 0x59, 0x01, 0x8a, 0x96, 0xeb, 0xd7, 0xf1,
-	0x49, 0x38, 0xee, 0x18, 0x7d, 0xf8, 0x24, 0x9a, 0x32, 0xed, 0xa1, 0xf8, 0x2d, 0x38, 0x15, 0xcb,
-	0xb9, 0xe2, 0x4c, 0xe8, 0x1b, 0x4e, 0xbd, 0x89, 0x59, 0xb7, 0x78, 0xe8, 0x7d, 0xa6, 0xf8, 0x0d,
-	0xcc, 0x35, 0x2f, 0x59, 0xad, 0x49, 0xa9, 0xbc, 0x8b, 0x00, 0x45, 0x93, 0x74, 0x68, 0xe0, 0xf7,
-	0xe0, 0xe4, 0x52, 0xb4, 0xac, 0xaa, 0x89, 0xe6, 0x52, 0x78, 0xcf, 0x03, 0x14, 0x2d, 0xd6, 0xaf,
-	0x06, 0xed, 0xed, 0x68, 0x9a, 0x3e, 0xc2, 0xe2, 0x77, 0x30, 0x55, 0x15, 0xcf, 0xb9, 0xd8, 0x7b,
-	0xb6, 0xa1, 0xbd, 0x18, 0x68, 0xbb, 0x6e, 0x90, 0x9e, 0x10, 0xe1, 0x07, 0x80, 0xc1, 0x3f, 0x5e,
-	0xc0, 0xb4, 0x11, 0x3f, 0x84, 0xbc, 0x13, 0xee, 0x33, 0x3c, 0x83, 0x8b, 0x9a, 0x09, 0xed, 0x22,
-	0x7c, 0x09, 0x73, 0xca, 0x0a, 0xde, 0xb2, 0x8a, 0x51, 0xd7, 0x3a, 0x0e, 0x2a, 0x46, 0xa8, 0x3b,
-	0x09, 0x7d, 0x70, 0xc6, 0x46, 0x9e, 0x5e, 0x5e, 0xf8, 0x0b, 0xc1, 0xb4, 0x97, 0xc4, 0x5b, 0xb8,
-	0xec, 0x45, 0x6f, 0x4a, 0x49, 0x59, 0x61, 0x60, 0xcb, 0xb5, 0x7f, 0x66, 0xee, 0x14, 0xbf, 0x1c,
-	0x51, 0xa9, 0xa3, 0x46, 0x15, 0xbe, 0x82, 0x59, 0xc6, 0x8b, 0x82, 0x64, 0x05, 0x33, 0xef, 0x31,
-	0x4b, 0x1f, 0xea, 0x70, 0x05, 0xce, 0x98, 0xf9, 0xf8, 0x30, 0x53, 0x98, 0x6c, 0x37, 0x3b, 0x17,
-	0x1d, 0x93, 0xaf, 0x9b, 0x9d, 0x6b, 0x6d, 0x5e, 0xfe, 0x39, 0xf8, 0xe8, 0xef, 0xc1, 0x47, 0xff,
-	0x0e, 0x3e, 0xfa, 0x6e, 0x27, 0xc6, 0x54, 0x66, 0x9b, 0x0f, 0x71, 0xfd, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x64, 0xc1, 0x29, 0xfa, 0x6d, 0x02, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x66, 0xed, 0x60, 0x27, 0x13, 0x27, 0x98, 0x15, 0x42, 0x56, 0x40, 0x96, 0xf1, 0x05, 0x4b,
+	0x28, 0x89, 0x94, 0xde, 0xb8, 0x91, 0xa8, 0x12, 0x1c, 0x40, 0x91, 0xb9, 0x71, 0xa9, 0x36, 0xde,
+	0x21, 0x5d, 0x61, 0xef, 0x5a, 0xeb, 0x4d, 0x4a, 0x1f, 0x85, 0x37, 0xe2, 0xc8, 0x23, 0xa0, 0x1c,
+	0x79, 0x0a, 0x14, 0xdb, 0xa9, 0xd3, 0xf6, 0x34, 0x3f, 0xdf, 0xf7, 0xcd, 0x7c, 0x63, 0x2f, 0x78,
+	0x95, 0x61, 0x66, 0x57, 0xcd, 0x4a, 0xad, 0x8c, 0xa2, 0xfd, 0x9b, 0x6b, 0x66, 0x2a, 0x56, 0x96,
+	0x93, 0x0f, 0x5b, 0x61, 0xae, 0x77, 0x9b, 0x59, 0xa6, 0x8a, 0x39, 0xca, 0xbd, 0xba, 0x2d, 0xb5,
+	0xfa, 0x79, 0x3b, 0xaf, 0x69, 0xd9, 0x74, 0x8b, 0x72, 0xba, 0x67, 0xb9, 0xe0, 0xcc, 0xe0, 0xfc,
+	0x51, 0xd2, 0x0c, 0x9b, 0x8c, 0xb6, 0x28, 0x51, 0xb3, 0xbc, 0x29, 0xe3, 0x7f, 0x16, 0x38, 0x5f,
+	0xeb, 0x65, 0x74, 0x0c, 0x96, 0xe0, 0x01, 0x89, 0x48, 0x32, 0x48, 0x2d, 0xc1, 0xe9, 0x05, 0x38,
+	0x8d, 0x8d, 0xc0, 0x8a, 0x48, 0x32, 0x5e, 0xbc, 0x9a, 0x9d, 0x7c, 0xcc, 0x1a, 0x45, 0x1b, 0x2e,
+	0xe5, 0xae, 0x48, 0x5b, 0x2a, 0x7d, 0x03, 0x9e, 0xc6, 0x4c, 0x94, 0x02, 0xa5, 0xb9, 0x12, 0x3c,
+	0xb0, 0xeb, 0x71, 0xc3, 0xbb, 0xde, 0x27, 0x4e, 0x5f, 0xc3, 0xc0, 0x88, 0x02, 0x2b, 0xc3, 0x8a,
+	0x32, 0xe8, 0x45, 0x24, 0xb1, 0xd3, 0xae, 0x41, 0xdf, 0x83, 0x97, 0x29, 0xb9, 0x47, 0x5d, 0x31,
+	0x23, 0x94, 0x0c, 0x9e, 0x46, 0x24, 0x19, 0x2e, 0x5e, 0x76, 0xbb, 0x57, 0x67, 0x68, 0x7a, 0x8f,
+	0x4b, 0xdf, 0x81, 0x5b, 0x6a, 0x91, 0x09, 0xb9, 0x0d, 0x9c, 0x5a, 0xf6, 0xbc, 0x93, 0xad, 0x1b,
+	0x20, 0x3d, 0x31, 0xe8, 0x5b, 0x70, 0x50, 0x6b, 0xa5, 0xab, 0xc0, 0x8d, 0xec, 0x64, 0xb8, 0x78,
+	0xd6, 0x71, 0x2f, 0x8f, 0xfd, 0xb4, 0x85, 0xe3, 0x8f, 0x00, 0xdd, 0xa1, 0x74, 0x08, 0xee, 0x4e,
+	0xfe, 0x90, 0xea, 0x46, 0xfa, 0x4f, 0x68, 0x1f, 0x7a, 0x15, 0x4a, 0xe3, 0x13, 0x3a, 0x82, 0x01,
+	0xc7, 0x5c, 0xec, 0x51, 0x23, 0xf7, 0xad, 0x23, 0xa0, 0x91, 0x71, 0xdf, 0xa6, 0x00, 0xce, 0x77,
+	0x26, 0x72, 0xe4, 0x7e, 0x2f, 0x0e, 0xc1, 0x3b, 0x77, 0xff, 0xf0, 0x8b, 0xc7, 0xbf, 0x08, 0xb8,
+	0xad, 0x4f, 0xba, 0x82, 0x51, 0xeb, 0xf4, 0xaa, 0x50, 0x1c, 0xf3, 0x9a, 0x36, 0x5e, 0x84, 0x8f,
+	0x2e, 0x3a, 0xc5, 0xcf, 0x47, 0x56, 0xea, 0x95, 0x67, 0x15, 0x9d, 0x40, 0x7f, 0x23, 0xf2, 0x9c,
+	0x6d, 0x72, 0xac, 0x7f, 0x62, 0x3f, 0xbd, 0xab, 0xe3, 0x29, 0x78, 0xe7, 0xca, 0xfb, 0x87, 0xb9,
+	0x60, 0xaf, 0x96, 0x6b, 0x9f, 0x1c, 0x93, 0x2f, 0xcb, 0xb5, 0x6f, 0x2d, 0x5f, 0xfc, 0x3e, 0x84,
+	0xe4, 0xcf, 0x21, 0x24, 0x7f, 0x0f, 0x21, 0xf9, 0xe6, 0xcc, 0x6b, 0x53, 0x1b, 0xa7, 0x7e, 0x45,
+	0x17, 0xff, 0x07, 0x00, 0x4a, 0x8e, 0xe2, 0x1e, 0xb1, 0x02, 0x00, 0x00,
 }
 
 func (m *Status) Marshal() (dAtA []byte, err error) {
@@ -334,6 +348,20 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Pricing != nil {
 		{
 			size, err := m.Pricing.MarshalToSizedBuffer(dAtA[:i])
@@ -500,6 +528,12 @@ func (m *Status) Size() (n int) {
 		l = m.Pricing.Size()
 		n += 1 + l + sovStatus(uint64(l))
 	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -749,6 +783,40 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &Error{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(dAtA[iNdEx:])
@@ -23,6 +23,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type User_Role int32
+
+const (
+	// keeps the current role. New users are created as USER
+	User_UNSPECIFIED User_Role = 0
+	User_ADMIN       User_Role = 1
+	User_USER        User_Role = 2
+)
+
+var User_Role_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "ADMIN",
+	2: "USER",
+}
+
+var User_Role_value = map[string]int32{
+	"UNSPECIFIED": 0,
+	"ADMIN":       1,
+	"USER":        2,
+}
+
+func (x User_Role) String() string {
+	return proto.EnumName(User_Role_name, int32(x))
+}
+
+func (User_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{3, 0}
+}
+
 type TokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	ExpiresAfter         string   `protobuf:"bytes,2,opt,name=expires_after,json=expiresAfter,proto3" json:"expires_after,omitempty"`
@@ -181,11 +210,12 @@ func (m *ChangePwdRequest) GetNewPassword() string {
 }
 
 type User struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Username             string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string    `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role                 User_Role `protobuf:"varint,3,opt,name=role,proto3,enum=whatsapp.User_Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
@@ -235,37 +265,244 @@ func (m *User) GetPassword() string {
 	return ""
 }
 
+func (m *User) GetRole() User_Role {
+	if m != nil {
+		return m.Role
+	}
+	return User_UNSPECIFIED
+}
+
+// UpdateUserRequest changes the password and/or the role of a user
+type UpdateUserRequest struct {
+	Password             string    `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Role                 User_Role `protobuf:"varint,2,opt,name=role,proto3,enum=whatsapp.User_Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
+func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{4}
+}
+func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+}
+func (m *UpdateUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+
+func (m *UpdateUserRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *UpdateUserRequest) GetRole() User_Role {
+	if m != nil {
+		return m.Role
+	}
+	return User_UNSPECIFIED
+}
+
+// UserInfo describes a user without its password
+type UserInfo struct {
+	Username               string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role                   User_Role `protobuf:"varint,2,opt,name=role,proto3,enum=whatsapp.User_Role" json:"role,omitempty"`
+	PasswordChangeRequired bool      `protobuf:"varint,3,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+	Created                int64     `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	PasswordChanged        int64     `protobuf:"varint,5,opt,name=password_changed,json=passwordChanged,proto3" json:"password_changed,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}  `json:"-"`
+	XXX_unrecognized       []byte    `json:"-"`
+	XXX_sizecache          int32     `json:"-"`
+}
+
+func (m *UserInfo) Reset()         { *m = UserInfo{} }
+func (m *UserInfo) String() string { return proto.CompactTextString(m) }
+func (*UserInfo) ProtoMessage()    {}
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{5}
+}
+func (m *UserInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserInfo.Merge(m, src)
+}
+func (m *UserInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserInfo proto.InternalMessageInfo
+
+func (m *UserInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserInfo) GetRole() User_Role {
+	if m != nil {
+		return m.Role
+	}
+	return User_UNSPECIFIED
+}
+
+func (m *UserInfo) GetPasswordChangeRequired() bool {
+	if m != nil {
+		return m.PasswordChangeRequired
+	}
+	return false
+}
+
+func (m *UserInfo) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *UserInfo) GetPasswordChanged() int64 {
+	if m != nil {
+		return m.PasswordChanged
+	}
+	return 0
+}
+
+type UserList struct {
+	Users                []*UserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UserList) Reset()         { *m = UserList{} }
+func (m *UserList) String() string { return proto.CompactTextString(m) }
+func (*UserList) ProtoMessage()    {}
+func (*UserList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{6}
+}
+func (m *UserList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserList.Merge(m, src)
+}
+func (m *UserList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserList proto.InternalMessageInfo
+
+func (m *UserList) GetUsers() []*UserInfo {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("whatsapp.User_Role", User_Role_name, User_Role_value)
 	proto.RegisterType((*TokenResponse)(nil), "whatsapp.TokenResponse")
 	proto.RegisterType((*LoginResponse)(nil), "whatsapp.LoginResponse")
 	proto.RegisterType((*ChangePwdRequest)(nil), "whatsapp.ChangePwdRequest")
 	proto.RegisterType((*User)(nil), "whatsapp.User")
+	proto.RegisterType((*UpdateUserRequest)(nil), "whatsapp.UpdateUserRequest")
+	proto.RegisterType((*UserInfo)(nil), "whatsapp.UserInfo")
+	proto.RegisterType((*UserList)(nil), "whatsapp.UserList")
 }
 
 func init() { proto.RegisterFile("users.proto", fileDescriptor_030765f334c86cea) }
 
 var fileDescriptor_030765f334c86cea = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x6b, 0xc2, 0x40,
-	0x10, 0x85, 0x49, 0xaa, 0xd6, 0x4e, 0x14, 0x64, 0x11, 0x2a, 0x1e, 0x82, 0xc4, 0x8b, 0x14, 0x4c,
-	0xc0, 0xde, 0x0b, 0xda, 0x5b, 0x69, 0x41, 0x96, 0xf6, 0xd2, 0x43, 0x65, 0x35, 0xd3, 0x18, 0x6a,
-	0x76, 0xb7, 0xbb, 0xab, 0xd1, 0x7f, 0xd8, 0x63, 0x7f, 0x42, 0xf1, 0x67, 0xf4, 0x54, 0xb2, 0xb1,
-	0x16, 0xe9, 0x6d, 0xf6, 0xbd, 0xc7, 0x37, 0xf3, 0x16, 0xbc, 0xb5, 0x46, 0xa5, 0x43, 0xa9, 0x84,
-	0x11, 0xa4, 0x9e, 0x2f, 0x99, 0xd1, 0x4c, 0xca, 0xee, 0x38, 0x49, 0xcd, 0x72, 0x3d, 0x0f, 0x17,
-	0x22, 0x8b, 0x90, 0x6f, 0xc4, 0x4e, 0x2a, 0xb1, 0xdd, 0x45, 0x36, 0xb6, 0x18, 0x26, 0xc8, 0x87,
-	0x1b, 0xb6, 0x4a, 0x63, 0x66, 0x30, 0xfa, 0x37, 0x94, 0xb0, 0x2e, 0x64, 0x68, 0x58, 0x39, 0x07,
-	0x77, 0xd0, 0x7c, 0x14, 0x6f, 0xc8, 0x29, 0x6a, 0x29, 0xb8, 0x46, 0xd2, 0x86, 0xaa, 0x29, 0x84,
-	0x8e, 0xd3, 0x73, 0x06, 0x17, 0xb4, 0x7c, 0x90, 0x3e, 0x34, 0x71, 0x2b, 0x53, 0x85, 0x7a, 0xc6,
-	0x5e, 0x0d, 0xaa, 0x8e, 0x6b, 0xdd, 0xc6, 0x41, 0x1c, 0x17, 0x5a, 0xf0, 0x02, 0xcd, 0x7b, 0x91,
-	0xa4, 0x7f, 0x2c, 0x1f, 0x2a, 0xc5, 0x2a, 0x8b, 0xf2, 0x46, 0x10, 0xda, 0xbd, 0x0f, 0x68, 0x18,
-	0xb5, 0x3a, 0x19, 0x42, 0xd5, 0x96, 0xec, 0xb8, 0xbd, 0xb3, 0x81, 0x37, 0xba, 0x0c, 0x7f, 0x5b,
-	0x86, 0x27, 0x37, 0xd1, 0x32, 0x15, 0xdc, 0x40, 0xeb, 0x76, 0xc9, 0x78, 0x82, 0xd3, 0x3c, 0xa6,
-	0xf8, 0xbe, 0x46, 0x6d, 0xc8, 0x15, 0x34, 0x38, 0xe6, 0x33, 0xc9, 0xb4, 0xce, 0x85, 0x8a, 0xcb,
-	0xab, 0x27, 0xe7, 0xdf, 0x93, 0x8a, 0x72, 0x5b, 0x75, 0xea, 0x71, 0xcc, 0xa7, 0x07, 0x2f, 0x98,
-	0x42, 0xe5, 0x49, 0xa3, 0x22, 0x7d, 0xa8, 0x17, 0x40, 0xce, 0x32, 0x3c, 0xcd, 0x57, 0xe9, 0xd1,
-	0x28, 0x42, 0x47, 0xa8, 0x7b, 0x0a, 0x3d, 0x1a, 0x93, 0xf6, 0xc7, 0xde, 0x77, 0x3e, 0xf7, 0xbe,
-	0xf3, 0xb5, 0xf7, 0x9d, 0xe7, 0x5a, 0x94, 0x89, 0x18, 0x57, 0xf3, 0x9a, 0xfd, 0xda, 0xeb, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x6a, 0x2f, 0xbc, 0xc2, 0x01, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xff, 0x71, 0xed, 0xd6, 0xbd, 0x69, 0xfe, 0x9a, 0xa1, 0x02, 0x2b, 0x8b, 0x28, 0x72,
+	0x17, 0x04, 0x44, 0x1c, 0x11, 0x58, 0xb0, 0x42, 0x4a, 0xda, 0x20, 0x05, 0xb5, 0x55, 0x34, 0x25,
+	0x1b, 0x16, 0x44, 0x4e, 0x7c, 0x9b, 0x58, 0x24, 0x1e, 0x77, 0x3c, 0x69, 0xda, 0x2d, 0x6f, 0xc4,
+	0x5b, 0xb0, 0x42, 0x3c, 0x02, 0xca, 0x63, 0x74, 0x85, 0x66, 0x1c, 0xa7, 0x35, 0x5d, 0xc0, 0x6e,
+	0xe6, 0x9e, 0xeb, 0x6f, 0xce, 0x99, 0x3b, 0x86, 0xd2, 0x22, 0x45, 0x91, 0xfa, 0x89, 0xe0, 0x92,
+	0x53, 0x7b, 0x39, 0x0d, 0x64, 0x1a, 0x24, 0x49, 0xa5, 0x3d, 0x89, 0xe4, 0x74, 0x31, 0xf2, 0xc7,
+	0x7c, 0xde, 0xc4, 0xf8, 0x8a, 0xdf, 0x24, 0x82, 0x5f, 0xdf, 0x34, 0x75, 0xdb, 0xb8, 0x31, 0xc1,
+	0xb8, 0x71, 0x15, 0xcc, 0xa2, 0x30, 0x90, 0xd8, 0x7c, 0xb0, 0xc8, 0x60, 0x15, 0x98, 0xa3, 0x0c,
+	0xb2, 0xb5, 0xf7, 0x01, 0xca, 0x1f, 0xf9, 0x17, 0x8c, 0x19, 0xa6, 0x09, 0x8f, 0x53, 0xa4, 0x07,
+	0x60, 0x49, 0x55, 0x70, 0x49, 0x8d, 0xd4, 0x77, 0x59, 0xb6, 0xa1, 0x87, 0x50, 0xc6, 0xeb, 0x24,
+	0x12, 0x98, 0x0e, 0x83, 0x0b, 0x89, 0xc2, 0x35, 0xb4, 0xba, 0xb7, 0x2e, 0xb6, 0x55, 0xcd, 0xfb,
+	0x0c, 0xe5, 0x13, 0x3e, 0x89, 0xee, 0x58, 0x55, 0x30, 0xd5, 0x51, 0x1a, 0x55, 0x6a, 0x81, 0xaf,
+	0xcf, 0x3d, 0x45, 0x19, 0x30, 0x5d, 0xa7, 0x0d, 0xb0, 0x74, 0x48, 0xd7, 0xa8, 0x6d, 0xd5, 0x4b,
+	0xad, 0xa7, 0x7e, 0x9e, 0xd2, 0x2f, 0x78, 0x62, 0x59, 0x97, 0xf7, 0x0e, 0x9c, 0xa3, 0x69, 0x10,
+	0x4f, 0xb0, 0xbf, 0x0c, 0x19, 0x5e, 0x2e, 0x30, 0x95, 0xf4, 0x05, 0xec, 0xc5, 0xb8, 0x1c, 0x26,
+	0x41, 0x9a, 0x2e, 0xb9, 0x08, 0x33, 0xd7, 0x9d, 0x9d, 0xdb, 0x8e, 0x29, 0x0c, 0xc7, 0x66, 0xa5,
+	0x18, 0x97, 0xfd, 0xb5, 0xe6, 0x7d, 0x23, 0x60, 0x0e, 0x52, 0x14, 0xf4, 0x10, 0x6c, 0x45, 0x8c,
+	0x83, 0x39, 0x16, 0x3f, 0xb0, 0xd8, 0x46, 0x50, 0x4d, 0x1b, 0xaa, 0x51, 0xa4, 0x6e, 0x04, 0xfa,
+	0x0a, 0x4c, 0xc1, 0x67, 0xe8, 0x6e, 0xd5, 0x48, 0xfd, 0xff, 0xd6, 0xe3, 0xbb, 0x00, 0xea, 0x1c,
+	0x9f, 0xf1, 0x19, 0x76, 0xec, 0xdb, 0x8e, 0xf5, 0x95, 0x18, 0x0e, 0x61, 0xba, 0xd5, 0x7b, 0x09,
+	0xa6, 0xaa, 0xd3, 0x7d, 0x28, 0x0d, 0xce, 0xce, 0xfb, 0xdd, 0xa3, 0xde, 0xfb, 0x5e, 0xf7, 0xd8,
+	0xf9, 0x8f, 0xee, 0x82, 0xd5, 0x3e, 0x3e, 0xed, 0x9d, 0x39, 0x84, 0xda, 0x60, 0x0e, 0xce, 0xbb,
+	0xcc, 0x31, 0xbc, 0x11, 0x3c, 0x1a, 0x24, 0x6a, 0x76, 0x0a, 0x98, 0x87, 0xae, 0xdc, 0xb3, 0x96,
+	0x8d, 0xe9, 0xa1, 0x23, 0xe3, 0xdf, 0x1d, 0xfd, 0x20, 0x60, 0x2b, 0xb5, 0x17, 0x5f, 0x70, 0xc5,
+	0x2e, 0xde, 0xcd, 0xbd, 0x2b, 0x79, 0xf6, 0x57, 0x76, 0x46, 0xa4, 0x6f, 0xc1, 0xcd, 0x0d, 0x0d,
+	0xc7, 0x7a, 0x64, 0x43, 0x81, 0x97, 0x8b, 0x48, 0x60, 0xa8, 0xaf, 0xca, 0x66, 0x4f, 0x72, 0x3d,
+	0x9b, 0x28, 0x5b, 0xab, 0xd4, 0x85, 0x9d, 0xb1, 0xc0, 0x40, 0x62, 0xe8, 0x9a, 0x35, 0x52, 0xdf,
+	0x62, 0xf9, 0x96, 0x3e, 0x07, 0xe7, 0x0f, 0x66, 0xe8, 0x5a, 0xba, 0x65, 0xbf, 0xc8, 0x0a, 0xbd,
+	0x37, 0x59, 0x9e, 0x93, 0x28, 0x95, 0xb4, 0x9e, 0xbf, 0x31, 0xa2, 0xdf, 0x18, 0x2d, 0x9a, 0x56,
+	0x91, 0xd7, 0xcf, 0xab, 0x73, 0xf0, 0x7d, 0x55, 0x25, 0x3f, 0x57, 0x55, 0xf2, 0x6b, 0x55, 0x25,
+	0x9f, 0xb6, 0x9b, 0x73, 0x1e, 0xe2, 0x6c, 0xb4, 0xad, 0xff, 0x93, 0xd7, 0xbf, 0x07, 0x00, 0x70,
+	0xf9, 0xc5, 0x6e, 0x8f, 0x03, 0x00, 0x00,
 }
 
 func (m *TokenResponse) Marshal() (dAtA []byte, err error) {
@@ -420,6 +657,11 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Password) > 0 {
 		i -= len(m.Password)
 		copy(dAtA[i:], m.Password)
@@ -437,6 +679,145 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PasswordChanged != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.PasswordChanged))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Created != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PasswordChangeRequired {
+		i--
+		if m.PasswordChangeRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Role != 0 {
+		i = encodeVarintUsers(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintUsers(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUsers(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUsers(v)
 	base := offset
@@ -520,22 +901,90 @@ func (m *User) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUsers(uint64(l))
 	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovUsers(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozUsers(x uint64) (n int) {
-	return sovUsers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UpdateUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *TokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *UserInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovUsers(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovUsers(uint64(m.Role))
+	}
+	if m.PasswordChangeRequired {
+		n += 2
+	}
+	if m.Created != 0 {
+		n += 1 + sovUsers(uint64(m.Created))
+	}
+	if m.PasswordChanged != 0 {
+		n += 1 + sovUsers(uint64(m.PasswordChanged))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUsers(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovUsers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUsers(x uint64) (n int) {
+	return sovUsers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -944,6 +1393,372 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			}
 			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= User_Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= User_Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= User_Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordChangeRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PasswordChangeRequired = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordChanged", wireType)
+			}
+			m.PasswordChanged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PasswordChanged |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &UserInfo{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsers(dAtA[iNdEx:])
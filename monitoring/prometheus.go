@@ -2,12 +2,14 @@ package monitoring
 
 import (
 	"compress/gzip"
+	"io"
 	"strings"
 	"sync"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
 	"github.com/valyala/fasthttp"
 )
@@ -29,6 +31,9 @@ var (
 	registry  *prometheus.Registry
 	namespace = "whatsapp_mock"
 
+	// The metrics of the accounts of a server are separated by the tenant label.
+	// The label of the default account is empty.
+
 	ApiRequestDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -53,7 +58,25 @@ var (
 			Name:      "webhook_queue_length",
 			Help:      "The current length of the webhook queue.",
 		},
-		[]string{"type"},
+		[]string{"tenant", "target", "type"},
+	)
+
+	WebhookQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "webhook_queue_depth",
+			Help:      "The current number of queued webhook requests.",
+		},
+		[]string{"tenant", "target"},
+	)
+
+	WebhookQueueDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "webhook_queue_dropped",
+			Help:      "The amount of webhook requests dropped because the queue was full.",
+		},
+		[]string{"tenant", "target", "policy"},
 	)
 
 	WebhookRequestDuration = prometheus.NewHistogramVec(
@@ -63,7 +86,34 @@ var (
 			Help:      "The HTTP request latencies of the webhook in seconds.",
 			Buckets:   []float64{0.2, 0.5, 1, 2, 5},
 		},
-		[]string{"status", "url"},
+		[]string{"tenant", "target", "status", "url"},
+	)
+
+	Messages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "messages",
+			Help:      "The amount of inbound and outbound messages.",
+		},
+		[]string{"tenant", "direction", "type"},
+	)
+
+	Statuses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "statuses",
+			Help:      "The amount of generated statuses of outbound messages.",
+		},
+		[]string{"tenant", "status"},
+	)
+
+	WebhookChaosEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "webhook_chaos_events",
+			Help:      "The amount of webhook requests affected by the chaos mode.",
+		},
+		[]string{"tenant", "target", "action"},
 	)
 )
 
@@ -75,11 +125,37 @@ func init() {
 
 	// API
 	registry.MustRegister(ApiRequestDuration)
+	registry.MustRegister(Messages)
+	registry.MustRegister(Statuses)
 
 	// Webhook
 	registry.MustRegister(WebhookQueueLength)
+	registry.MustRegister(WebhookQueueDepth)
+	registry.MustRegister(WebhookQueueDropped)
 	registry.MustRegister(WebhookRequestDuration)
 	registry.MustRegister(WebhookGeneratedMessages)
+	registry.MustRegister(WebhookChaosEvents)
+}
+
+// Gather collects the metrics of the provided collectors only
+func Gather(cs ...prometheus.Collector) ([]*dto.MetricFamily, error) {
+	r := prometheus.NewRegistry()
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r.Gather()
+}
+
+// WriteText writes the metric families in the prometheus text format
+func WriteText(w io.Writer, data []*dto.MetricFamily) error {
+	for _, entry := range data {
+		if _, err := expfmt.MetricFamilyToText(w, entry); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func PrometheusHandler(ctx *fasthttp.RequestCtx) {
@@ -0,0 +1,140 @@
+package store
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+const fileExt = ".json"
+
+var (
+	marsheler = jsonpb.Marshaler{
+		OrigName: true,
+		Indent:   "  ",
+	}
+	unmarsheler = jsonpb.Unmarshaler{
+		AllowUnknownFields: true,
+	}
+)
+
+// FileStore keeps each entry as JSON file in a directory.
+// The files are replaced atomically so that a crash never leaves a partially written entry.
+type FileStore struct {
+	dir string
+	mux sync.RWMutex
+}
+
+// NewFileStore returns a store which keeps the entries in dir. The directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)+fileExt), nil
+}
+
+func (s *FileStore) Get(key string, m proto.Message) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	s.mux.RLock()
+	data, err := ioutil.ReadFile(path)
+	s.mux.RUnlock()
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	m.Reset()
+	return true, unmarsheler.Unmarshal(bytes.NewReader(data), m)
+}
+
+func (s *FileStore) Put(key string, m proto.Message) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err = marsheler.Marshal(buf, m); err != nil {
+		return err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(buf.Bytes()); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *FileStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *FileStore) Keys(prefix string) ([]string, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	keys := []string{}
+	err := filepath.Walk(s.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, fileExt) || strings.HasPrefix(info.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		key := strings.TrimSuffix(filepath.ToSlash(rel), fileExt)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
@@ -0,0 +1,74 @@
+package store_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+)
+
+var _ = Describe("FileStore", func() {
+
+	var (
+		dir string
+		s   *store.FileStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "store")
+		Expect(err).To(BeNil())
+		s, err = store.NewFileStore(dir)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should survive a restart", func() {
+		Expect(s.Put("media/abc", &model.MediaMetadata{Id: "abc", MimeType: "image/png"})).To(BeNil())
+
+		reopened, err := store.NewFileStore(dir)
+		Expect(err).To(BeNil())
+		metadata := &model.MediaMetadata{}
+		found, err := reopened.Get("media/abc", metadata)
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(metadata.MimeType).To(Equal("image/png"))
+	})
+
+	It("Should list and delete the entries", func() {
+		Expect(s.Put("config", &model.InternalConfig{UploadDir: "media/"})).To(BeNil())
+		Expect(s.Put("media/abc", &model.MediaMetadata{Id: "abc"})).To(BeNil())
+
+		keys, err := s.Keys("media/")
+		Expect(err).To(BeNil())
+		Expect(keys).To(Equal([]string{"media/abc"}))
+
+		Expect(s.Delete("media/abc")).To(BeNil())
+		found, err := s.Get("media/abc", &model.MediaMetadata{})
+		Expect(err).To(BeNil())
+		Expect(found).To(BeFalse())
+	})
+
+	It("Should prefix the keys", func() {
+		tenant := store.WithPrefix(s, "tenants/acme/")
+		Expect(tenant.Put("config", &model.InternalConfig{UploadDir: "acme/"})).To(BeNil())
+
+		keys, err := s.Keys("")
+		Expect(err).To(BeNil())
+		Expect(keys).To(Equal([]string{"tenants/acme/config"}))
+
+		keys, err = tenant.Keys("")
+		Expect(err).To(BeNil())
+		Expect(keys).To(Equal([]string{"config"}))
+	})
+
+	It("Should reject keys outside of the directory", func() {
+		Expect(s.Put("../config", &model.InternalConfig{})).To(Equal(store.ErrInvalidKey))
+	})
+})
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// MemoryStore keeps the entries in memory. It is used if the state should not survive a restart.
+type MemoryStore struct {
+	entries map[string][]byte
+	mux     sync.RWMutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: map[string][]byte{},
+	}
+}
+
+func (s *MemoryStore) Get(key string, m proto.Message) (bool, error) {
+	s.mux.RLock()
+	data, ok := s.entries[key]
+	s.mux.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	m.Reset()
+	return true, proto.Unmarshal(data, m)
+}
+
+func (s *MemoryStore) Put(key string, m proto.Message) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	s.entries[key] = data
+	s.mux.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Delete(key string) error {
+	s.mux.Lock()
+	delete(s.entries, key)
+	s.mux.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Keys(prefix string) ([]string, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	keys := []string{}
+	for key := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+var (
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// Store persists the mutable state of the server. Each entry is a protobuf message.
+// Keys are slash separated paths, e.g. config or media/<id>.
+type Store interface {
+	// Get reads the entry into m and returns whether it exists
+	Get(key string, m proto.Message) (found bool, err error)
+	// Put writes the entry. It replaces an existing entry
+	Put(key string, m proto.Message) error
+	// Delete removes the entry. Missing entries are ignored
+	Delete(key string) error
+	// Keys returns the sorted keys of all entries which start with the prefix
+	Keys(prefix string) ([]string, error)
+}
+
+func validKey(key string) bool {
+	return key != "" &&
+		!strings.HasPrefix(key, "/") &&
+		!strings.HasSuffix(key, "/") &&
+		path.Clean(key) == key &&
+		!strings.HasPrefix(key, "..")
+}
+
+type prefixStore struct {
+	Store
+	prefix string
+}
+
+// WithPrefix returns a store which prefixes all keys of s with the prefix.
+// It is used to keep the state of several accounts in the same store.
+func WithPrefix(s Store, prefix string) Store {
+	return &prefixStore{Store: s, prefix: prefix}
+}
+
+func (s *prefixStore) Get(key string, m proto.Message) (bool, error) {
+	return s.Store.Get(s.prefix+key, m)
+}
+
+func (s *prefixStore) Put(key string, m proto.Message) error {
+	return s.Store.Put(s.prefix+key, m)
+}
+
+func (s *prefixStore) Delete(key string) error {
+	return s.Store.Delete(s.prefix + key)
+}
+
+func (s *prefixStore) Keys(prefix string) ([]string, error) {
+	keys, err := s.Store.Keys(s.prefix + prefix)
+	for i := range keys {
+		keys[i] = strings.TrimPrefix(keys[i], s.prefix)
+	}
+	return keys, err
+}
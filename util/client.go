@@ -4,13 +4,16 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/valyala/fasthttp"
 )
 
-var (
-	DefaultClient = &fasthttp.Client{
+var defaultClient atomic.Value // *fasthttp.Client
+
+func init() {
+	defaultClient.Store(&fasthttp.Client{
 		NoDefaultUserAgentHeader:      true,
 		DisablePathNormalizing:        false,
 		DisableHeaderNamesNormalizing: false,
@@ -24,24 +27,76 @@ var (
 		MaxIdleConnDuration:       30 * time.Second,
 		MaxConnDuration:           0, // unlimited
 		MaxIdemponentCallAttempts: 2,
-	}
-)
+	})
+}
+
+// DefaultClient returns the client which is used for webhook requests if no other client is configured
+func DefaultClient() *fasthttp.Client {
+	return defaultClient.Load().(*fasthttp.Client)
+}
 
-func NewClient(rootCa []byte) error {
+// SetDefaultClient replaces the default client. Requests which are in flight finish with the previous client.
+func SetDefaultClient(client *fasthttp.Client) {
+	defaultClient.Store(client)
+}
+
+// NewTLSConfig returns a new tls config for webhook requests.
+// If rootCa is set, the certificate of the server is validated against it.
+// If clientCert and clientKey are set, they are used as client certificate for mutual TLS.
+func NewTLSConfig(rootCa, clientCert, clientKey []byte, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
 
 	if rootCa != nil {
 		caCertPool := x509.NewCertPool()
 
 		if !caCertPool.AppendCertsFromPEM(rootCa) {
-			return fmt.Errorf("unable to parse provided certificate")
+			return nil, fmt.Errorf("unable to parse provided certificate")
 		}
+		tlsConfig.RootCAs = caCertPool
+	}
 
-		DefaultClient.TLSConfig = &tls.Config{
-			RootCAs:            caCertPool,
-			InsecureSkipVerify: false,
-			MinVersion:         tls.VersionTLS12,
+	if clientCert != nil || clientKey != nil {
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse provided client certificate: %v", err)
 		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
 
+	return tlsConfig, nil
+}
+
+// NewTLSClient returns a new client with the same settings as the DefaultClient
+// which uses the tls config returned by NewTLSConfig
+func NewTLSClient(rootCa, clientCert, clientKey []byte, insecureSkipVerify bool) (*fasthttp.Client, error) {
+	tlsConfig, err := NewTLSConfig(rootCa, clientCert, clientKey, insecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fasthttp.Client{
+		NoDefaultUserAgentHeader:      true,
+		DisablePathNormalizing:        false,
+		DisableHeaderNamesNormalizing: false,
+		ReadTimeout:                   5 * time.Second,
+		WriteTimeout:                  10 * time.Second,
+		TLSConfig:                     tlsConfig,
+		MaxConnsPerHost:               8,
+		MaxIdleConnDuration:           30 * time.Second,
+		MaxConnDuration:               0, // unlimited
+		MaxIdemponentCallAttempts:     2,
+	}, nil
+}
+
+// NewClient replaces the DefaultClient with a client which uses the provided CA and client certificate
+func NewClient(rootCa, clientCert, clientKey []byte, insecureSkipVerify bool) error {
+	client, err := NewTLSClient(rootCa, clientCert, clientKey, insecureSkipVerify)
+	if err != nil {
+		return err
+	}
+	SetDefaultClient(client)
 	return nil
 }
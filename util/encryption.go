@@ -1,71 +1,195 @@
 package util
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// KDFArgon2id derives the key with Argon2id
+	KDFArgon2id byte = 1
+
+	envelopeVersion = 1
+	saltSize        = 16
+	keySize         = 32
+	nonceSize       = 12 // standard nonce size of GCM
+	tagSize         = 16 // standard tag size of GCM
 )
 
+var (
+	// envelopeMagic identifies an encrypted envelope. Data without it has been
+	// encrypted with a single SHA-256 of the password as key.
+	envelopeMagic = []byte("WAENC")
+
+	// DefaultKDFParams are the cost parameters of the key derivation of new envelopes
+	DefaultKDFParams = KDFParams{Time: 3, Memory: 64 * 1024, Threads: 4}
+	// MaxKDFParams limit the cost parameters which are accepted when an envelope is decrypted.
+	// The parameters are read from the untrusted envelope, so they are capped close to the defaults.
+	MaxKDFParams = KDFParams{Time: 2 * DefaultKDFParams.Time, Memory: 2 * DefaultKDFParams.Memory, Threads: 2 * DefaultKDFParams.Threads}
+
+	ErrCiphertextTooShort = errors.New("ciphertext is too short")
+)
+
+// KDFParams are the cost parameters of Argon2id. Memory is given in KiB.
+type KDFParams struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
 func Sha256Hash(data []byte) []byte {
 	hash := sha256.Sum256(data)
 	return hash[:]
 }
 
+// Encrypt encrypts the data of the reader with AES-GCM. The key is derived from the password with Argon2id.
+// The envelope consists of the magic bytes, its version, the KDF id, salt and cost parameters, the nonce
+// and the ciphertext. The header is authenticated as additional data.
 func Encrypt(password string, reader io.Reader) ([]byte, error) {
 
-	key := Sha256Hash([]byte(password))
-
 	data, err := ioutil.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := aes.NewCipher(key)
-	if err != nil {
+	salt := make([]byte, saltSize)
+	if _, err = io.ReadFull(rand.Reader, salt); err != nil {
 		return nil, err
 	}
+	params := DefaultKDFParams
+	key := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, keySize)
 
-	gcm, err := cipher.NewGCM(c)
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, err
 	}
 
 	nonce := make([]byte, gcm.NonceSize())
-
 	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
 		return nil, err
 	}
 
-	return gcm.Seal(nonce, nonce, data, nil), nil
+	header := &bytes.Buffer{}
+	header.Write(envelopeMagic)
+	header.WriteByte(envelopeVersion)
+	header.WriteByte(KDFArgon2id)
+	header.WriteByte(byte(len(salt)))
+	header.Write(salt)
+	_ = binary.Write(header, binary.BigEndian, params.Time)
+	_ = binary.Write(header, binary.BigEndian, params.Memory)
+	header.WriteByte(params.Threads)
+	header.WriteByte(byte(len(nonce)))
+	header.Write(nonce)
+
+	return gcm.Seal(header.Bytes(), nonce, data, header.Bytes()), nil
 }
 
+// Decrypt decrypts an envelope which has been created by Encrypt.
+// Data without envelope is decrypted with a single SHA-256 of the password as key.
 func Decrypt(password string, reader io.Reader) ([]byte, error) {
 
-	key := Sha256Hash([]byte(password))
-
 	ciphertext, err := ioutil.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := aes.NewCipher(key)
+	if !bytes.HasPrefix(ciphertext, envelopeMagic) {
+		return decryptLegacy(password, ciphertext)
+	}
+
+	r := bytes.NewReader(ciphertext[len(envelopeMagic):])
+	var version, kdf, saltLen, nonceLen byte
+	var params KDFParams
+	if version, err = r.ReadByte(); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version %d", version)
+	}
+	if kdf, err = r.ReadByte(); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if kdf != KDFArgon2id {
+		return nil, fmt.Errorf("unsupported key derivation function %d", kdf)
+	}
+	if saltLen, err = r.ReadByte(); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if saltLen != saltSize {
+		return nil, fmt.Errorf("invalid salt size %d", saltLen)
+	}
+	salt := make([]byte, saltLen)
+	if _, err = io.ReadFull(r, salt); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if err = binary.Read(r, binary.BigEndian, &params.Time); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if err = binary.Read(r, binary.BigEndian, &params.Memory); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if params.Threads, err = r.ReadByte(); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if params.Time == 0 || params.Time > MaxKDFParams.Time ||
+		params.Memory == 0 || params.Memory > MaxKDFParams.Memory ||
+		params.Threads == 0 || params.Threads > MaxKDFParams.Threads {
+		return nil, fmt.Errorf("invalid key derivation parameters")
+	}
+	if nonceLen, err = r.ReadByte(); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	if nonceLen != nonceSize {
+		return nil, fmt.Errorf("invalid nonce size %d", nonceLen)
+	}
+	nonce := make([]byte, nonceLen)
+	if _, err = io.ReadFull(r, nonce); err != nil {
+		return nil, ErrCiphertextTooShort
+	}
+	// the expensive key derivation is only done for complete envelopes
+	if r.Len() < tagSize {
+		return nil, ErrCiphertextTooShort
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, keySize)
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, err
 	}
+	header := ciphertext[:len(ciphertext)-r.Len()]
+	return gcm.Open(nil, nonce, ciphertext[len(header):], header)
+}
 
-	gcm, err := cipher.NewGCM(c)
+// decryptLegacy decrypts data which has been encrypted without envelope
+func decryptLegacy(password string, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(Sha256Hash([]byte(password)))
 	if err != nil {
 		return nil, err
 	}
 
 	nonceSize := gcm.NonceSize()
 	if len(ciphertext) < nonceSize {
-		return nil, err
+		return nil, ErrCiphertextTooShort
 	}
 
 	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
 	return gcm.Open(nil, nonce, ciphertext, nil)
 }
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(c)
+}
@@ -34,3 +34,31 @@ func (x *Set) Contains(key string) bool {
 	_, found := x.l[key]
 	return found
 }
+
+func (x *Set) Len() int {
+	x.m.RLock()
+	defer x.m.RUnlock()
+	return len(x.l)
+}
+
+// List returns all keys of the set
+func (x *Set) List() []string {
+	x.m.RLock()
+	defer x.m.RUnlock()
+	keys := make([]string, 0, len(x.l))
+	for key := range x.l {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Replace replaces all keys of the set with the provided keys
+func (x *Set) Replace(keys []string) {
+	l := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		l[key] = struct{}{}
+	}
+	x.m.Lock()
+	x.l = l
+	x.m.Unlock()
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+)
+
+// Chaos applies the configured misbehaviour to the webhook requests of a target.
+// It can be changed at runtime and is shared by all targets of a webhook.
+type Chaos struct {
+	cfg *model.WebhookChaos
+	mux sync.RWMutex
+}
+
+func NewChaos(cfg *model.WebhookChaos) *Chaos {
+	c := &Chaos{}
+	c.Set(cfg)
+	return c
+}
+
+// Set replaces the current chaos configuration
+func (c *Chaos) Set(cfg *model.WebhookChaos) {
+	if cfg == nil {
+		cfg = &model.WebhookChaos{}
+	}
+	c.mux.Lock()
+	c.cfg = proto.Clone(cfg).(*model.WebhookChaos)
+	c.mux.Unlock()
+}
+
+// Get returns a copy of the current chaos configuration
+func (c *Chaos) Get() *model.WebhookChaos {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return proto.Clone(c.cfg).(*model.WebhookChaos)
+}
+
+func (c *Chaos) Enabled() bool {
+	if c == nil {
+		return false
+	}
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return c.cfg.Enabled
+}
+
+// Apply drops, splits and duplicates the provided requests and shuffles
+// the result if a reorder window is configured. The returned requests are sent in order.
+// The tenant and target label the chaos events in the metrics.
+func (c *Chaos) Apply(tenant, target string, reqs []*model.WebhookRequest) []*model.WebhookRequest {
+	cfg := c.Get()
+	out := make([]*model.WebhookRequest, 0, len(reqs))
+
+	for _, req := range reqs {
+		if hit(cfg.DropProbability) {
+			monitoring.WebhookChaosEvents.WithLabelValues(tenant, target, "dropped").Inc()
+			continue
+		}
+
+		parts := []*model.WebhookRequest{req}
+		if hit(cfg.SplitProbability) {
+			parts = split(req)
+			if len(parts) > 1 {
+				monitoring.WebhookChaosEvents.WithLabelValues(tenant, target, "split").Inc()
+			}
+		}
+
+		for _, part := range parts {
+			out = append(out, part)
+			if hit(cfg.DuplicateProbability) {
+				monitoring.WebhookChaosEvents.WithLabelValues(tenant, target, "duplicated").Inc()
+				out = append(out, part)
+			}
+		}
+	}
+
+	if cfg.ReorderWindow > 0 && len(out) > 1 {
+		rand.Shuffle(len(out), func(i, j int) {
+			out[i], out[j] = out[j], out[i]
+		})
+		monitoring.WebhookChaosEvents.WithLabelValues(tenant, target, "reordered").Inc()
+	}
+	return out
+}
+
+// Latency returns a random duration between the configured min and max latency
+func (c *Chaos) Latency() time.Duration {
+	cfg := c.Get()
+	min, max := cfg.MinLatencyMs, cfg.MaxLatencyMs
+	if max <= min {
+		return time.Duration(min) * time.Millisecond
+	}
+	return time.Duration(min+rand.Int31n(max-min)) * time.Millisecond
+}
+
+// ReorderWindow returns the number of requests which are shuffled
+func (c *Chaos) ReorderWindow() int {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return int(c.cfg.ReorderWindow)
+}
+
+// split distributes the messages and stati of the request over
+// several requests. Each message and status is contained in exactly one request.
+func split(req *model.WebhookRequest) []*model.WebhookRequest {
+	n := len(req.Messages) + len(req.Statuses)
+	if n < 2 {
+		return []*model.WebhookRequest{req}
+	}
+
+	parts := make([]*model.WebhookRequest, 2+rand.Intn(n-1))
+	for i := range parts {
+		parts[i] = AcquireWebhookRequest()
+		parts[i].Reset()
+	}
+
+	for _, msg := range req.Messages {
+		p := parts[rand.Intn(len(parts))]
+		p.Messages = append(p.Messages, msg)
+	}
+	for _, s := range req.Statuses {
+		p := parts[rand.Intn(len(parts))]
+		p.Statuses = append(p.Statuses, s)
+	}
+
+	out := parts[:0]
+	for _, p := range parts {
+		if len(p.Messages) == 0 && len(p.Statuses) == 0 {
+			continue
+		}
+		if len(p.Messages) > 0 {
+			p.Contacts = req.Contacts
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func hit(probability float64) bool {
+	return probability > 0 && rand.Float64() < probability
+}
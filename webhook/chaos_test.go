@@ -0,0 +1,124 @@
+package webhook_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+)
+
+var _ = Describe("Chaos", func() {
+
+	events := func(target, action string) float64 {
+		return testutil.ToFloat64(monitoring.WebhookChaosEvents.WithLabelValues("acme", target, action))
+	}
+	newRequest := func() *model.WebhookRequest {
+		return &model.WebhookRequest{
+			Messages: []*model.Message{{Id: "m1"}, {Id: "m2"}},
+			Contacts: []*model.Contact{{WaId: "491701111111"}},
+			Statuses: []*model.Status{{Id: "s1"}},
+		}
+	}
+
+	Context("Disabled", func() {
+		var chaos *webhook.Chaos
+
+		It("Should not be enabled without a config", func() {
+			Expect(chaos.Enabled()).To(BeFalse())
+			Expect(webhook.NewChaos(nil).Enabled()).To(BeFalse())
+		})
+	})
+
+	Context("Drop", func() {
+		chaos := webhook.NewChaos(&model.WebhookChaos{Enabled: true, DropProbability: 1})
+
+		It("Should drop all requests", func() {
+			out := chaos.Apply("acme", "chaos-drop", []*model.WebhookRequest{newRequest(), newRequest()})
+			Expect(out).To(BeEmpty())
+			Expect(events("chaos-drop", "dropped")).To(Equal(2.0))
+		})
+	})
+
+	Context("Duplicate", func() {
+		chaos := webhook.NewChaos(&model.WebhookChaos{Enabled: true, DuplicateProbability: 1})
+
+		It("Should send each request twice", func() {
+			first, second := newRequest(), newRequest()
+			out := chaos.Apply("acme", "chaos-duplicate", []*model.WebhookRequest{first, second})
+			Expect(out).To(HaveLen(4))
+			Expect(out[0]).To(BeIdenticalTo(first))
+			Expect(out[1]).To(BeIdenticalTo(first))
+			Expect(out[2]).To(BeIdenticalTo(second))
+			Expect(out[3]).To(BeIdenticalTo(second))
+			Expect(events("chaos-duplicate", "duplicated")).To(Equal(2.0))
+		})
+	})
+
+	Context("Split", func() {
+		chaos := webhook.NewChaos(&model.WebhookChaos{Enabled: true, SplitProbability: 1})
+
+		It("Should distribute each message and status to exactly one request", func() {
+			out := chaos.Apply("acme", "chaos-split", []*model.WebhookRequest{newRequest()})
+			Expect(len(out)).To(BeNumerically(">=", 2))
+
+			ids := []string{}
+			for _, req := range out {
+				Expect(len(req.Messages) + len(req.Statuses)).To(BeNumerically(">", 0))
+				if len(req.Messages) > 0 {
+					Expect(req.Contacts).To(HaveLen(1))
+				} else {
+					Expect(req.Contacts).To(BeEmpty())
+				}
+				for _, msg := range req.Messages {
+					ids = append(ids, msg.Id)
+				}
+				for _, s := range req.Statuses {
+					ids = append(ids, s.Id)
+				}
+			}
+			Expect(ids).To(ConsistOf("m1", "m2", "s1"))
+			Expect(events("chaos-split", "split")).To(Equal(1.0))
+		})
+
+		It("Should not split a request with a single entry", func() {
+			req := &model.WebhookRequest{Messages: []*model.Message{{Id: "m1"}}}
+			out := chaos.Apply("acme", "chaos-split-single", []*model.WebhookRequest{req})
+			Expect(out).To(HaveLen(1))
+			Expect(out[0]).To(BeIdenticalTo(req))
+			Expect(events("chaos-split-single", "split")).To(Equal(0.0))
+		})
+	})
+
+	Context("Reorder", func() {
+		chaos := webhook.NewChaos(&model.WebhookChaos{Enabled: true, ReorderWindow: 5})
+
+		It("Should keep all requests", func() {
+			reqs := []*model.WebhookRequest{}
+			for i := int32(0); i < 5; i++ {
+				reqs = append(reqs, &model.WebhookRequest{ErrorCounter: i})
+			}
+			in := append([]*model.WebhookRequest(nil), reqs...)
+
+			out := chaos.Apply("acme", "chaos-reorder", reqs)
+			Expect(out).To(ConsistOf(in))
+			Expect(chaos.ReorderWindow()).To(Equal(5))
+			Expect(events("chaos-reorder", "reordered")).To(Equal(1.0))
+		})
+	})
+
+	Context("Latency", func() {
+		chaos := webhook.NewChaos(&model.WebhookChaos{Enabled: true, MinLatencyMs: 10, MaxLatencyMs: 20})
+
+		It("Should be between the min and max latency", func() {
+			for i := 0; i < 10; i++ {
+				latency := chaos.Latency()
+				Expect(latency).To(BeNumerically(">=", 10*time.Millisecond))
+				Expect(latency).To(BeNumerically("<", 20*time.Millisecond))
+			}
+		})
+	})
+})
@@ -0,0 +1,217 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+)
+
+// OverflowPolicy defines the behaviour of a queue once it is full
+type OverflowPolicy string
+
+const (
+	// OverflowBlock waits until there is space in the queue or the timeout is reached
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDropOldest removes the oldest request of the queue to make space for the new one
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowReject rejects the new request immediately
+	OverflowReject OverflowPolicy = "reject"
+
+	DefaultQueueTimeout = 5 * time.Second
+)
+
+var (
+	ErrQueueFull = errors.New("webhook queue is full")
+)
+
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	switch p := OverflowPolicy(s); p {
+	case OverflowBlock, OverflowDropOldest, OverflowReject:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unsupported overflow policy %s", s)
+	}
+}
+
+// Queue is a bounded FIFO queue of webhook requests
+type Queue struct {
+	items    []*model.WebhookRequest
+	size     int
+	policy   OverflowPolicy
+	timeout  time.Duration
+	mux      sync.Mutex
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	changed  chan struct{}
+}
+
+func NewQueue(size int, policy OverflowPolicy, timeout time.Duration) *Queue {
+	q := &Queue{
+		notEmpty: make(chan struct{}, 1),
+		notFull:  make(chan struct{}, 1),
+		changed:  make(chan struct{}, 1),
+	}
+	q.Configure(size, policy, timeout)
+	return q
+}
+
+// Configure changes the size, policy and timeout of the queue.
+// Requests that are already queued are kept even if the new size is smaller.
+func (q *Queue) Configure(size int, policy OverflowPolicy, timeout time.Duration) {
+	if size < 1 {
+		size = 1
+	}
+	q.mux.Lock()
+	q.size = size
+	q.policy = policy
+	q.timeout = timeout
+	q.mux.Unlock()
+}
+
+func (q *Queue) Len() int {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	return len(q.items)
+}
+
+// Full returns whether the queue has reached its size
+func (q *Queue) Full() bool {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	return len(q.items) >= q.size
+}
+
+func (q *Queue) Policy() OverflowPolicy {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	return q.policy
+}
+
+// Push adds the request to the queue according to the overflow policy.
+// If the oldest request had to be dropped, it is returned.
+// If the request could not be added, ErrQueueFull is returned.
+func (q *Queue) Push(whReq *model.WebhookRequest) (dropped *model.WebhookRequest, err error) {
+	return q.push(whReq, true)
+}
+
+// TryPush adds the request to the queue like Push but never waits.
+// With the block policy, ErrQueueFull is returned immediately if the queue is full.
+func (q *Queue) TryPush(whReq *model.WebhookRequest) (dropped *model.WebhookRequest, err error) {
+	return q.push(whReq, false)
+}
+
+func (q *Queue) push(whReq *model.WebhookRequest, wait bool) (dropped *model.WebhookRequest, err error) {
+	var timer *time.Timer
+
+	for {
+		q.mux.Lock()
+		if len(q.items) < q.size {
+			q.items = append(q.items, whReq)
+			q.signal(q.notEmpty)
+			q.signal(q.changed)
+			if len(q.items) < q.size {
+				q.signal(q.notFull)
+			}
+			q.mux.Unlock()
+			return dropped, nil
+		}
+
+		switch q.policy {
+		case OverflowDropOldest:
+			dropped = q.items[0]
+			q.items[0] = nil
+			q.items = append(q.items[1:], whReq)
+			q.signal(q.notEmpty)
+			q.signal(q.changed)
+			q.mux.Unlock()
+			return dropped, nil
+
+		case OverflowReject:
+			q.mux.Unlock()
+			return nil, ErrQueueFull
+		}
+
+		if !wait {
+			q.mux.Unlock()
+			return nil, ErrQueueFull
+		}
+
+		if timer == nil {
+			timer = time.NewTimer(q.timeout)
+			defer timer.Stop()
+		}
+		q.mux.Unlock()
+
+		select {
+		case <-q.notFull:
+		case <-timer.C:
+			return nil, ErrQueueFull
+		}
+	}
+}
+
+// Pop removes the oldest request of the queue.
+// It blocks until a request is available or done is closed.
+func (q *Queue) Pop(done chan struct{}) (*model.WebhookRequest, bool) {
+	for {
+		if whReq, ok := q.TryPop(); ok {
+			return whReq, true
+		}
+
+		select {
+		case <-q.notEmpty:
+		case <-done:
+			return nil, false
+		}
+	}
+}
+
+// TryPop removes the oldest request of the queue without blocking
+func (q *Queue) TryPop() (*model.WebhookRequest, bool) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
+	if len(q.items) == 0 {
+		return nil, false
+	}
+	whReq := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+
+	q.signal(q.notFull)
+	q.signal(q.changed)
+	if len(q.items) > 0 {
+		q.signal(q.notEmpty)
+	}
+	return whReq, true
+}
+
+// Changed is signaled whenever requests have been added to or removed from the queue
+func (q *Queue) Changed() <-chan struct{} {
+	return q.changed
+}
+
+// Snapshot returns a copy of the queued requests
+func (q *Queue) Snapshot() *model.WebhookQueue {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	snapshot := &model.WebhookQueue{
+		Requests: make([]*model.WebhookRequest, len(q.items)),
+	}
+	for i, whReq := range q.items {
+		snapshot.Requests[i] = proto.Clone(whReq).(*model.WebhookRequest)
+	}
+	return snapshot
+}
+
+// signal wakes up a single waiter without blocking
+func (q *Queue) signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,92 @@
+package webhook_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+)
+
+var _ = Describe("Queue", func() {
+
+	first := &model.WebhookRequest{ErrorCounter: 1}
+	second := &model.WebhookRequest{ErrorCounter: 2}
+
+	Context("Policy block", func() {
+		q := webhook.NewQueue(1, webhook.OverflowBlock, 50*time.Millisecond)
+
+		It("Should time out if the queue stays full", func() {
+			_, err := q.Push(first)
+			Expect(err).To(BeNil())
+
+			start := time.Now()
+			_, err = q.Push(second)
+			Expect(err).To(Equal(webhook.ErrQueueFull))
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		})
+
+		It("Should wait until there is space in the queue", func() {
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				q.TryPop()
+			}()
+			_, err := q.Push(second)
+			Expect(err).To(BeNil())
+			Expect(q.Len()).To(Equal(1))
+		})
+	})
+
+	Context("Policy drop_oldest", func() {
+		q := webhook.NewQueue(1, webhook.OverflowDropOldest, 0)
+
+		It("Should return the dropped request", func() {
+			_, err := q.Push(first)
+			Expect(err).To(BeNil())
+
+			dropped, err := q.Push(second)
+			Expect(err).To(BeNil())
+			Expect(dropped).To(Equal(first))
+
+			whReq, ok := q.TryPop()
+			Expect(ok).To(BeTrue())
+			Expect(whReq).To(Equal(second))
+		})
+	})
+
+	Context("Policy reject", func() {
+		q := webhook.NewQueue(1, webhook.OverflowReject, time.Second)
+
+		It("Should reject requests if the queue is full", func() {
+			_, err := q.Push(first)
+			Expect(err).To(BeNil())
+			Expect(q.Full()).To(BeTrue())
+
+			_, err = q.Push(second)
+			Expect(err).To(Equal(webhook.ErrQueueFull))
+		})
+	})
+
+	Context("Pop", func() {
+		q := webhook.NewQueue(10, webhook.OverflowBlock, time.Second)
+
+		It("Should return false once done is closed", func() {
+			done := make(chan struct{})
+			close(done)
+			_, ok := q.Pop(done)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should return requests in order", func() {
+			q.Push(first)
+			q.Push(second)
+			done := make(chan struct{})
+
+			whReq, _ := q.Pop(done)
+			Expect(whReq).To(Equal(first))
+			whReq, _ = q.Pop(done)
+			Expect(whReq).To(Equal(second))
+		})
+	})
+})
@@ -0,0 +1,372 @@
+package webhook
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
+	"github.com/ron96G/whatsapp-bizapi-mock/util"
+	"github.com/valyala/fasthttp"
+
+	log "github.com/ron96G/go-common-utils/log"
+)
+
+const (
+	DefaultTargetName = "default"
+
+	EventMessages = "messages"
+	EventStatuses = "statuses"
+	EventAccount  = "account"
+)
+
+// Target is a single receiver of webhook requests.
+// Each target has its own queue, client and filter which allows
+// to feed multiple consumers independently of each other.
+type Target struct {
+	Name                  string
+	Filter                *model.WebhookFilter
+	Queue                 *Queue
+	Log                   log.Logger
+	Compress              bool
+	CompressMinsize       int
+	MaxConcurrentRequests int
+	// Chaos is applied to the requests of this target if it is enabled
+	Chaos *Chaos
+	// Store persists the queue of this target. If nil, the queue is lost on restart.
+	Store store.Store
+	// Tenant is the account of the target in the metrics
+	Tenant    string
+	url       atomic.Value // string
+	client    atomic.Value // *fasthttp.Client
+	userAgent string
+}
+
+func NewTarget(name, url, version string) *Target {
+	t := &Target{
+		Name:                  name,
+		Queue:                 NewQueue(MaxQueueLength, OverflowBlock, DefaultQueueTimeout),
+		Log:                   log.New("webhook_logger", "target", name),
+		Compress:              false,
+		CompressMinsize:       2048,
+		MaxConcurrentRequests: 1,
+		userAgent:             "WhatsappMockserver/" + version,
+	}
+	t.SetURL(url)
+	t.SetClient(nil)
+	return t
+}
+
+// URL returns the URL to which the requests of the target are sent
+func (t *Target) URL() string {
+	return t.url.Load().(string)
+}
+
+// SetURL changes the URL of the target. It is safe to call while requests are sent.
+func (t *Target) SetURL(url string) {
+	t.url.Store(url)
+}
+
+// Client returns the client which is used to send the requests of the target.
+// If the target has no own client, util.DefaultClient is used instead.
+func (t *Target) Client() *fasthttp.Client {
+	if client := t.client.Load().(*fasthttp.Client); client != nil {
+		return client
+	}
+	return util.DefaultClient()
+}
+
+// SetClient replaces the client of the target. If nil, util.DefaultClient is used.
+// It is safe to call while requests are sent.
+func (t *Target) SetClient(client *fasthttp.Client) {
+	t.client.Store(client)
+}
+
+// NewTargetFromConfig creates a new target based on the provided config.
+// If a CA or client certificate is configured, the target uses its own client.
+func NewTargetFromConfig(cfg *model.WebhookTarget, version string) (*Target, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("name of webhook target cannot be empty")
+	}
+	if cfg.Url == "" {
+		return nil, fmt.Errorf("url of webhook target %s cannot be empty", cfg.Name)
+	}
+
+	t := NewTarget(cfg.Name, cfg.Url, version)
+	t.Filter = cfg.Filter
+	t.Compress = cfg.Compress
+	if cfg.CompressMinsize > 0 {
+		t.CompressMinsize = int(cfg.CompressMinsize)
+	}
+	if cfg.MaxConcurrentRequests > 0 {
+		t.MaxConcurrentRequests = int(cfg.MaxConcurrentRequests)
+	}
+
+	if cfg.Ca != nil || cfg.ClientCert != nil || cfg.InsecureSkipVerify {
+		client, err := util.NewTLSClient(cfg.Ca, cfg.ClientCert, cfg.ClientKey, cfg.InsecureSkipVerify)
+		if err != nil {
+			return nil, fmt.Errorf("webhook target %s: %v", cfg.Name, err)
+		}
+		t.SetClient(client)
+	}
+	return t, nil
+}
+
+// Matches returns a copy of the request that only contains the content
+// which is allowed by the filter of the target. If nothing matches, nil is returned.
+func (t *Target) Matches(whReq *model.WebhookRequest) *model.WebhookRequest {
+	f := t.Filter
+	if f == nil || (len(f.Events) == 0 && len(f.Contacts) == 0) {
+		return whReq
+	}
+
+	out := AcquireWebhookRequest()
+	out.Reset()
+
+	if len(f.Events) == 0 || contains(f.Events, EventMessages) {
+		for _, msg := range whReq.Messages {
+			if len(f.Contacts) == 0 || contains(f.Contacts, msg.From) {
+				out.Messages = append(out.Messages, msg)
+			}
+		}
+		for _, c := range whReq.Contacts {
+			if len(f.Contacts) == 0 || contains(f.Contacts, c.WaId) {
+				out.Contacts = append(out.Contacts, c)
+			}
+		}
+	}
+
+	if len(f.Events) == 0 || contains(f.Events, EventStatuses) {
+		for _, s := range whReq.Statuses {
+			if len(f.Contacts) == 0 || contains(f.Contacts, s.RecipientId) {
+				out.Statuses = append(out.Statuses, s)
+			}
+		}
+	}
+
+	if len(f.Events) == 0 || contains(f.Events, EventAccount) {
+		out.Account = whReq.Account
+	}
+
+	if len(out.Messages) == 0 && len(out.Statuses) == 0 && len(out.Account) == 0 {
+		ReleaseWebhookRequest(out)
+		return nil
+	}
+	if len(out.Messages) == 0 {
+		out.Contacts = nil
+	}
+	return out
+}
+
+// Enqueue adds the request to the queue of the target.
+// If the queue is full, the request is handled according to the overflow policy of the queue.
+func (t *Target) Enqueue(whReq *model.WebhookRequest) error {
+	return t.enqueue(whReq, t.Queue.Push)
+}
+
+// requeue adds a failed request to the queue again without waiting for space,
+// as the worker which retries it would otherwise block on its own queue.
+// If the queue is full, the request is dropped.
+func (t *Target) requeue(whReq *model.WebhookRequest) {
+	_ = t.enqueue(whReq, t.Queue.TryPush)
+}
+
+func (t *Target) enqueue(whReq *model.WebhookRequest, push func(*model.WebhookRequest) (*model.WebhookRequest, error)) error {
+	dropped, err := push(whReq)
+	if err != nil {
+		t.drop(whReq, t.Queue.Policy())
+		return err
+	}
+	t.queued(whReq)
+	if dropped != nil {
+		t.dequeued(dropped)
+		t.drop(dropped, OverflowDropOldest)
+	}
+	return nil
+}
+
+func (t *Target) queued(whReq *model.WebhookRequest) {
+	monitoring.WebhookQueueLength.With(prometheus.Labels{"tenant": t.Tenant, "target": t.Name, "type": "message"}).Add(float64(len(whReq.Messages)))
+	monitoring.WebhookQueueLength.With(prometheus.Labels{"tenant": t.Tenant, "target": t.Name, "type": "status"}).Add(float64(len(whReq.Statuses)))
+	monitoring.WebhookQueueDepth.WithLabelValues(t.Tenant, t.Name).Set(float64(t.Queue.Len()))
+}
+
+func (t *Target) dequeued(whReq *model.WebhookRequest) {
+	monitoring.WebhookQueueLength.With(prometheus.Labels{"tenant": t.Tenant, "target": t.Name, "type": "message"}).Sub(float64(len(whReq.Messages)))
+	monitoring.WebhookQueueLength.With(prometheus.Labels{"tenant": t.Tenant, "target": t.Name, "type": "status"}).Sub(float64(len(whReq.Statuses)))
+	monitoring.WebhookQueueDepth.WithLabelValues(t.Tenant, t.Name).Set(float64(t.Queue.Len()))
+}
+
+func (t *Target) drop(whReq *model.WebhookRequest, policy OverflowPolicy) {
+	t.Log.Warn("Dropped webhook request as queue is full", "policy", policy,
+		"messages", len(whReq.Messages), "statuses", len(whReq.Statuses))
+	monitoring.WebhookQueueDropped.WithLabelValues(t.Tenant, t.Name, string(policy)).Inc()
+}
+
+// drain reads up to n requests from the queue without blocking
+func (t *Target) drain(batch []*model.WebhookRequest, n int) []*model.WebhookRequest {
+	for len(batch) < n {
+		whReq, ok := t.Queue.TryPop()
+		if !ok {
+			break
+		}
+		t.dequeued(whReq)
+		batch = append(batch, whReq)
+	}
+	return batch
+}
+
+func (t *Target) Send(req *fasthttp.Request) (*fasthttp.Response, error) {
+	client := t.Client()
+
+	start := time.Now()
+	urlStr := string(req.URI().Path())
+	resp := fasthttp.AcquireResponse()
+	err := client.Do(req, resp)
+	delta := float64(time.Since(start)) / float64(time.Second)
+	if err != nil {
+		monitoring.WebhookRequestDuration.WithLabelValues(t.Tenant, t.Name, "failed", urlStr).Observe(delta)
+		return nil, err
+	}
+
+	statusStr := strconv.Itoa(resp.StatusCode())
+	monitoring.WebhookRequestDuration.WithLabelValues(t.Tenant, t.Name, statusStr, urlStr).Observe(delta)
+	return resp, err
+}
+
+// Run starts MaxConcurrentRequests workers which send the requests of the queue to the webhook.
+// The workers are stopped once the done channel is closed.
+func (t *Target) Run(done chan struct{}, errors chan error) {
+	n := t.MaxConcurrentRequests
+	if n < 1 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		go t.worker(done, errors)
+	}
+	if t.Store != nil {
+		go t.persist(done, errors)
+	}
+}
+
+// persist writes the queue to the store whenever it has changed and once the target is stopped
+func (t *Target) persist(done chan struct{}, errors chan error) {
+	for {
+		select {
+		case <-t.Queue.Changed():
+			if err := t.Store.Put(t.Name, t.Queue.Snapshot()); err != nil {
+				errors <- err
+			}
+		case <-done:
+			if err := t.Store.Put(t.Name, t.Queue.Snapshot()); err != nil {
+				t.Log.Error("Unable to persist webhook queue", "error", err)
+			}
+			return
+		}
+	}
+}
+
+func (t *Target) worker(done chan struct{}, errors chan error) {
+	var waitInterval time.Duration
+
+	for {
+		whReq, ok := t.Queue.Pop(done)
+		if !ok {
+			return
+		}
+		t.dequeued(whReq)
+		batch := []*model.WebhookRequest{whReq}
+
+		chaos := t.Chaos.Enabled()
+		if chaos {
+			batch = t.Chaos.Apply(t.Tenant, t.Name, t.drain(batch, t.Chaos.ReorderWindow()))
+		}
+
+		for _, req := range batch {
+			time.Sleep(waitInterval)
+			if chaos {
+				time.Sleep(t.Chaos.Latency())
+			}
+
+			if err := t.deliver(req); err != nil {
+				waitInterval = waitInterval + 3*time.Second
+				errors <- err
+				// retry the request later. If the queue is full, it is dropped
+				t.requeue(req)
+				continue
+			}
+			waitInterval = 2
+
+			// requests may share their content with the requests of other targets.
+			// Therefore, the messages and stati are not returned to their pools
+			ReleaseWebhookRequest(req)
+		}
+	}
+}
+
+func (t *Target) deliver(whReq *model.WebhookRequest) (err error) {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+
+	writer := req.BodyWriter()
+
+	buf := util.AcquireBuffer()
+	buf.Reset()
+	defer util.ReleaseBuffer(buf)
+
+	if err = marsheler.Marshal(buf, whReq); err != nil {
+		return err
+	}
+
+	if t.Compress && buf.Len() > t.CompressMinsize {
+		gz := util.AcquireGzip()
+
+		gz.Reset(writer)
+		_, err = io.Copy(gz, buf)
+		gz.Close()
+		util.ReleaseGzip(gz)
+
+		if err != nil {
+			return err
+		}
+		req.Header.Add("Content-Encoding", "gzip")
+
+	} else if _, err = io.Copy(writer, buf); err != nil {
+		return err
+	}
+
+	url := t.URL()
+	req.SetRequestURI(url)
+	req.Header.Set("User-Agent", t.userAgent)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.SetMethod("POST")
+
+	resp, err := t.Send(req)
+	if err != nil {
+		return err
+	}
+
+	code := resp.StatusCode()
+	fasthttp.ReleaseResponse(resp)
+
+	if code >= 300 || code < 200 {
+		return fmt.Errorf("webook to %s failed with status %d", url, code)
+	}
+
+	t.Log.Info("Webhook succeeded", "url", url, "status_code", code)
+	return nil
+}
+
+func contains(slice []string, item string) bool {
+	for _, element := range slice {
+		if element == item {
+			return true
+		}
+	}
+	return false
+}
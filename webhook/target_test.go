@@ -0,0 +1,165 @@
+package webhook_test
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/ron96G/whatsapp-bizapi-mock/model"
+	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
+	"github.com/ron96G/whatsapp-bizapi-mock/webhook"
+	"github.com/valyala/fasthttp"
+)
+
+var _ = Describe("Target", func() {
+
+	whReq := &model.WebhookRequest{
+		Messages: []*model.Message{{Id: "m1", From: "491701111111"}, {Id: "m2", From: "491702222222"}},
+		Contacts: []*model.Contact{{WaId: "491701111111"}, {WaId: "491702222222"}},
+		Statuses: []*model.Status{{Id: "s1", RecipientId: "491701111111"}},
+		Account:  []*model.AccountEvent{{Event: "UPDATE"}},
+	}
+
+	Context("Filter", func() {
+		t := webhook.NewTarget("filter", "https://localhost/webhook", "test")
+
+		It("Should pass the request without a filter", func() {
+			Expect(t.Matches(whReq)).To(BeIdenticalTo(whReq))
+		})
+
+		It("Should only pass the allowed events", func() {
+			t.Filter = &model.WebhookFilter{Events: []string{webhook.EventStatuses}}
+			out := t.Matches(whReq)
+			Expect(out.Messages).To(BeEmpty())
+			Expect(out.Contacts).To(BeEmpty())
+			Expect(out.Statuses).To(HaveLen(1))
+			Expect(out.Account).To(BeEmpty())
+		})
+
+		It("Should only pass the content of the allowed contacts", func() {
+			t.Filter = &model.WebhookFilter{Contacts: []string{"491702222222"}}
+			out := t.Matches(whReq)
+			Expect(out.Messages).To(HaveLen(1))
+			Expect(out.Messages[0].Id).To(Equal("m2"))
+			Expect(out.Contacts).To(HaveLen(1))
+			Expect(out.Contacts[0].WaId).To(Equal("491702222222"))
+			Expect(out.Statuses).To(BeEmpty())
+			Expect(out.Account).To(HaveLen(1))
+		})
+
+		It("Should return nil if nothing matches", func() {
+			t.Filter = &model.WebhookFilter{Events: []string{webhook.EventMessages}, Contacts: []string{"491709999999"}}
+			Expect(t.Matches(whReq)).To(BeNil())
+		})
+	})
+
+	Context("Fan-out to multiple targets", func() {
+		wh := webhook.NewWebhook("https://localhost/webhook", "test", nil)
+		wh.Default().Filter = &model.WebhookFilter{Events: []string{webhook.EventMessages}}
+		account := webhook.NewTarget("account", "https://localhost/account", "test")
+		account.Filter = &model.WebhookFilter{Events: []string{webhook.EventAccount}}
+		full := webhook.NewTarget("full", "https://localhost/full", "test")
+		full.Queue = webhook.NewQueue(1, webhook.OverflowReject, 0)
+		PanicIfNotNil(wh.AddTarget(account))
+		PanicIfNotNil(wh.AddTarget(full))
+
+		first := wh.AddAccountEvents(&model.AccountEvent{Event: "FLAGGED"})
+		second := wh.AddAccountEvents(&model.AccountEvent{Event: "UNFLAGGED"})
+
+		It("Should reject a duplicate target", func() {
+			Expect(wh.AddTarget(webhook.NewTarget("account", "https://localhost/other", "test"))).ToNot(BeNil())
+		})
+
+		It("Should only queue the requests which match the filter of the target", func() {
+			Expect(wh.Default().Queue.Len()).To(Equal(0))
+		})
+
+		It("Should queue the requests of each target independently", func() {
+			Expect(first).To(BeNil())
+			Expect(second).To(Equal(webhook.ErrQueueFull))
+			Expect(full.Queue.Len()).To(Equal(1))
+			Expect(account.Queue.Len()).To(Equal(2))
+			Expect(wh.Accepting()).To(BeFalse())
+		})
+	})
+
+	Context("Overflow policies", func() {
+		newTarget := func(name string, policy webhook.OverflowPolicy) *webhook.Target {
+			t := webhook.NewTarget(name, "https://localhost/webhook", "test")
+			t.Queue = webhook.NewQueue(1, policy, 20*time.Millisecond)
+			return t
+		}
+		dropped := func(t *webhook.Target, policy webhook.OverflowPolicy) float64 {
+			return testutil.ToFloat64(monitoring.WebhookQueueDropped.WithLabelValues(t.Tenant, t.Name, string(policy)))
+		}
+		first := &model.WebhookRequest{ErrorCounter: 1}
+		second := &model.WebhookRequest{ErrorCounter: 2}
+
+		It("Should drop the new request after the timeout with block", func() {
+			t := newTarget("overflow-block", webhook.OverflowBlock)
+			Expect(t.Enqueue(first)).To(BeNil())
+			Expect(t.Enqueue(second)).To(Equal(webhook.ErrQueueFull))
+			Expect(dropped(t, webhook.OverflowBlock)).To(Equal(1.0))
+		})
+
+		It("Should drop the oldest request with drop_oldest", func() {
+			t := newTarget("overflow-drop-oldest", webhook.OverflowDropOldest)
+			Expect(t.Enqueue(first)).To(BeNil())
+			Expect(t.Enqueue(second)).To(BeNil())
+			Expect(t.Queue.Snapshot().Requests[0].ErrorCounter).To(Equal(int32(2)))
+			Expect(dropped(t, webhook.OverflowDropOldest)).To(Equal(1.0))
+		})
+
+		It("Should reject the new request with reject", func() {
+			t := newTarget("overflow-reject", webhook.OverflowReject)
+			Expect(t.Enqueue(first)).To(BeNil())
+			Expect(t.Enqueue(second)).To(Equal(webhook.ErrQueueFull))
+			Expect(dropped(t, webhook.OverflowReject)).To(Equal(1.0))
+		})
+	})
+
+	Context("Retry a failed request with a full queue", func() {
+		received := make(chan struct{}, 1)
+		release := make(chan struct{})
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		PanicIfNotNil(err)
+		server := &fasthttp.Server{Handler: func(ctx *fasthttp.RequestCtx) {
+			select {
+			case received <- struct{}{}:
+			default:
+			}
+			<-release
+			ctx.SetStatusCode(500)
+		}}
+		go server.Serve(ln)
+
+		t := webhook.NewTarget("retry", "http://"+ln.Addr().String()+"/webhook", "test")
+		t.Queue = webhook.NewQueue(1, webhook.OverflowBlock, time.Minute)
+		done := make(chan struct{})
+		errors := make(chan error, 10)
+
+		It("Should drop the request instead of blocking the worker", func() {
+			defer server.Shutdown()
+			defer close(done)
+			t.Run(done, errors)
+
+			Expect(t.Enqueue(&model.WebhookRequest{ErrorCounter: 1})).To(BeNil())
+			Eventually(received, time.Second).Should(Receive())
+			// the worker is delivering the first request while the queue is filled
+			Expect(t.Enqueue(&model.WebhookRequest{ErrorCounter: 2})).To(BeNil())
+			close(release)
+
+			Eventually(func() float64 {
+				return testutil.ToFloat64(monitoring.WebhookQueueDropped.WithLabelValues(t.Tenant, t.Name, string(webhook.OverflowBlock)))
+			}, time.Second).Should(Equal(1.0))
+		})
+	})
+})
+
+func PanicIfNotNil(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
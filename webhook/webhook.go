@@ -2,8 +2,6 @@ package webhook
 
 import (
 	"fmt"
-	"io"
-	"strconv"
 	"sync"
 	"time"
 
@@ -11,8 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/ron96G/whatsapp-bizapi-mock/model"
 	"github.com/ron96G/whatsapp-bizapi-mock/monitoring"
-	"github.com/ron96G/whatsapp-bizapi-mock/util"
-	"github.com/valyala/fasthttp"
+	"github.com/ron96G/whatsapp-bizapi-mock/store"
 
 	log "github.com/ron96G/go-common-utils/log"
 )
@@ -36,54 +33,135 @@ var (
 		Indent:       "  ",
 	}
 
+	// MaxQueueLength is the default number of webhook requests a target can queue
 	MaxQueueLength = 1000
 )
 
 type Webhook struct {
-	URL                       string
+	Targets                   []*Target
+	Chaos                     *Chaos
 	Generators                *model.Generators
 	StatusQueue               []*model.Status
-	Queue                     chan *model.WebhookRequest
 	Log                       log.Logger
-	WaitInterval              time.Duration
-	userAgent                 string
-	Compress                  bool
-	CompressMinsize           int
 	MaxStatiPerWebhookRequest int
 	StatusMergeInterval       time.Duration
-	mux                       sync.Mutex
+	// Tenant is the account of the webhook in the metrics
+	Tenant string
+	mux    sync.Mutex
 }
 
+// NewWebhook returns a new webhook with a single default target
+// which sends all webhook requests to the provided url
 func NewWebhook(url, version string, g *model.Generators) *Webhook {
+	chaos := NewChaos(nil)
+	target := NewTarget(DefaultTargetName, url, version)
+	target.Chaos = chaos
+
 	return &Webhook{
-		URL:                       url,
+		Targets:                   []*Target{target},
+		Chaos:                     chaos,
 		Generators:                g,
-		Queue:                     make(chan *model.WebhookRequest, 100),
 		Log:                       log.New("webhook_logger"),
-		userAgent:                 "WhatsappMockserver/" + version,
 		StatusQueue:               make([]*model.Status, 0),
-		WaitInterval:              0 * time.Second,
-		Compress:                  false,
-		CompressMinsize:           2048,
 		MaxStatiPerWebhookRequest: 2048,
 		StatusMergeInterval:       3 * time.Second,
 	}
 }
 
-func (w *Webhook) Send(req *fasthttp.Request) (*fasthttp.Response, error) {
-	start := time.Now()
-	urlStr := string(req.URI().Path())
-	resp := fasthttp.AcquireResponse()
-	err := util.DefaultClient.Do(req, resp)
-	delta := float64(time.Since(start)) / float64(time.Second)
-	if err != nil {
-		monitoring.WebhookRequestDuration.WithLabelValues("failed", urlStr).Observe(delta)
-		return nil, err
+// Default returns the default target of the webhook which is configured
+// using the application settings
+func (w *Webhook) Default() *Target {
+	return w.Targets[0]
+}
+
+// AddTarget adds a new target to the webhook.
+// Targets must be added before the webhook is started.
+func (w *Webhook) AddTarget(t *Target) error {
+	for _, existing := range w.Targets {
+		if existing.Name == t.Name {
+			return fmt.Errorf("webhook target %s already exists", t.Name)
+		}
+	}
+	if t.Chaos == nil {
+		t.Chaos = w.Chaos
+	}
+	t.Tenant = w.Tenant
+	w.Targets = append(w.Targets, t)
+	return nil
+}
+
+// SetTenant sets the account of the webhook and its targets in the metrics.
+// It must be called before the webhook is started.
+func (w *Webhook) SetTenant(name string) {
+	w.Tenant = name
+	for _, t := range w.Targets {
+		t.Tenant = name
+	}
+}
+
+// SetGenerators replaces the generators of inbound messages and stati,
+// e.g. if the contacts or the inbound media have been changed
+func (w *Webhook) SetGenerators(g *model.Generators) {
+	w.mux.Lock()
+	w.Generators = g
+	w.mux.Unlock()
+}
+
+// CurrentGenerators returns the generators which are currently used
+func (w *Webhook) CurrentGenerators() *model.Generators {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.Generators
+}
+
+// UseStore restores the queued requests of the targets from the store
+// and persists their queues from now on. It must be called before the webhook is started.
+func (w *Webhook) UseStore(s store.Store) error {
+	for _, t := range w.Targets {
+		queue := &model.WebhookQueue{}
+		if _, err := s.Get(t.Name, queue); err != nil {
+			return fmt.Errorf("unable to restore queue of webhook target %s: %v", t.Name, err)
+		}
+		for _, whReq := range queue.Requests {
+			_ = t.Enqueue(whReq)
+		}
+		if len(queue.Requests) > 0 {
+			w.Log.Info("Restored queued webhook requests", "target", t.Name, "requests", len(queue.Requests))
+		}
+		t.Store = s
+	}
+	return nil
+}
+
+// ConfigureQueues changes the size, overflow policy and timeout of the queues of all targets
+func (w *Webhook) ConfigureQueues(size int, policy OverflowPolicy, timeout time.Duration) {
+	for _, t := range w.Targets {
+		t.Queue.Configure(size, policy, timeout)
+	}
+}
+
+// Accepting returns false if a target rejects new requests because its queue is full.
+// This is used to apply backpressure to the API.
+func (w *Webhook) Accepting() bool {
+	for _, t := range w.Targets {
+		if t.Queue.Policy() == OverflowReject && t.Queue.Full() {
+			return false
+		}
 	}
+	return true
+}
 
-	statusStr := strconv.Itoa(resp.StatusCode())
-	monitoring.WebhookRequestDuration.WithLabelValues(statusStr, urlStr).Observe(delta)
-	return resp, err
+// dispatch adds the request to the queue of every target that matches it.
+// The request is added to all targets even if some of them fail.
+func (w *Webhook) dispatch(whReq *model.WebhookRequest) (err error) {
+	for _, t := range w.Targets {
+		if req := t.Matches(whReq); req != nil {
+			if tErr := t.Enqueue(req); tErr != nil {
+				err = tErr
+			}
+		}
+	}
+	return err
 }
 
 func (w *Webhook) AddStati(stati ...*model.Status) {
@@ -92,7 +170,27 @@ func (w *Webhook) AddStati(stati ...*model.Status) {
 	w.mux.Unlock()
 	amount := float64(len(stati))
 	monitoring.WebhookGeneratedMessages.With(prometheus.Labels{"type": "status"}).Add(amount)
-	monitoring.WebhookQueueLength.With(prometheus.Labels{"type": "status"}).Add(amount)
+	for _, s := range stati {
+		monitoring.Statuses.WithLabelValues(w.Tenant, s.Status.String()).Inc()
+	}
+}
+
+// PendingStati returns the number of stati which are not yet added to a webhook request
+func (w *Webhook) PendingStati() int {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return len(w.StatusQueue)
+}
+
+// AddAccountEvents sends the account events to all targets in a separate webhook request
+func (w *Webhook) AddAccountEvents(events ...*model.AccountEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	whReq := AcquireWebhookRequest()
+	whReq.Reset()
+	whReq.Account = events
+	return w.dispatch(whReq)
 }
 
 // collect all stati of outbound messages and send them to webhook
@@ -105,20 +203,20 @@ func (w *Webhook) statusRunner() (stop chan int) {
 				return
 			case <-time.After(w.StatusMergeInterval):
 				w.mux.Lock()
+				stati := w.getStati()
+				w.mux.Unlock()
 
-				curLen := len(w.StatusQueue)
-				if curLen == 0 {
-					w.mux.Unlock()
+				if len(stati) == 0 {
 					continue
 				}
 
 				whReq := AcquireWebhookRequest()
 				whReq.Reset()
+				whReq.Statuses = stati
 
-				whReq.Statuses = w.getStati()
-
-				w.Queue <- whReq
-				w.mux.Unlock()
+				if err := w.dispatch(whReq); err != nil {
+					w.Log.Warn("Unable to dispatch stati", "error", err)
+				}
 			}
 		}
 	}()
@@ -150,130 +248,49 @@ func (w *Webhook) getStati() []*model.Status {
 	return t
 }
 
-func (w *Webhook) GenerateWebhookRequests(numberOfEntries int, types ...string) []*model.Message {
-	w.mux.Lock()
-	defer w.mux.Unlock()
-
+// GenerateWebhookRequests generates the messages and adds them together with the current stati
+// to the queues of the targets. If a queue is full, ErrQueueFull is returned.
+func (w *Webhook) GenerateWebhookRequests(numberOfEntries int, types ...string) ([]*model.Message, error) {
 	whReq := AcquireWebhookRequest()
 	whReq.Reset()
 	var messages []*model.Message
+	g := w.CurrentGenerators()
 
 	if types[0] == "rnd" {
-		messages = w.Generators.GenerateRndMessages(numberOfEntries)
+		messages = g.GenerateRndMessages(numberOfEntries)
 	} else {
-		messages = w.Generators.GenerateMessages(numberOfEntries, types...)
+		messages = g.GenerateMessages(numberOfEntries, types...)
 	}
 	whReq.Messages = append(whReq.Messages, messages...)
-	whReq.Contacts = append(whReq.Contacts, w.Generators.Contacts...)
+	whReq.Contacts = append(whReq.Contacts, g.Contacts...)
 	whReq.Errors = nil // Set the errors array to nil to skip it in marshalling
+
+	w.mux.Lock()
 	whReq.Statuses = w.getStati()
-	w.Queue <- whReq
+	w.mux.Unlock()
 
 	amount := float64(numberOfEntries)
-	monitoring.WebhookQueueLength.With(prometheus.Labels{"type": "message"}).Add(amount)
 	monitoring.WebhookGeneratedMessages.With(prometheus.Labels{"type": "message"}).Add(amount)
-	return messages
+	for _, msg := range messages {
+		monitoring.Messages.WithLabelValues(w.Tenant, "inbound", msg.Type.String()).Inc()
+	}
+	return messages, w.dispatch(whReq)
 }
 
+// Run starts the workers of all targets and the merging of stati into webhook requests
 func (w *Webhook) Run(errors chan error) (stop chan int) {
 	stop = make(chan int, 1)
 	stopStatus := w.statusRunner()
+	done := make(chan struct{})
 
-	go func() {
-
-		for {
-			select {
-			case <-stop:
-				stopStatus <- 1
-				return
-
-			case whReq := <-w.Queue:
-				var err error
-				msgCount := len(whReq.Messages)
-				staCount := len(whReq.Statuses)
-
-				time.Sleep(w.WaitInterval)
-				req := fasthttp.AcquireRequest()
-
-				writer := req.BodyWriter()
-
-				buf := util.AcquireBuffer()
-				buf.Reset()
-
-				if err := marsheler.Marshal(buf, whReq); err != nil {
-					w.WaitInterval = w.WaitInterval + 3*time.Second
-					errors <- err
-					w.Queue <- whReq
-					continue
-				}
-
-				if w.Compress && buf.Len() > w.CompressMinsize {
-					gz := util.AcquireGzip()
-
-					gz.Reset(writer)
-					_, err = io.Copy(gz, buf)
-					gz.Close()
-
-					util.ReleaseBuffer(buf)
-					util.ReleaseGzip(gz)
-
-					if err != nil {
-						errors <- err
-					} else {
-						req.Header.Add("Content-Encoding", "gzip")
-						goto send
-					}
-
-				}
-
-				_, err = io.Copy(writer, buf)
-				if err != nil {
-					w.WaitInterval = w.WaitInterval + 3*time.Second
-					errors <- err
-					w.Queue <- whReq
-					continue
-				}
-
-			send:
-				req.SetRequestURI(w.URL)
-				req.Header.Set("User-Agent", w.userAgent)
-				req.Header.Set("Content-Type", "application/json")
-				req.Header.SetMethod("POST")
-
-				resp, err := w.Send(req)
-				if err != nil {
-					w.WaitInterval = w.WaitInterval + 3*time.Second
-					errors <- err
-					w.Queue <- whReq
-					continue
-				}
-
-				code := resp.StatusCode()
-				fasthttp.ReleaseResponse(resp)
-				fasthttp.ReleaseRequest(req)
-
-				if code >= 300 || code < 200 {
-					w.WaitInterval = w.WaitInterval + 3*time.Second
-					errors <- fmt.Errorf("webook to %s failed with status %d", w.URL, code)
-					w.Queue <- whReq
-					continue
-				}
-				w.WaitInterval = 2
-
-				monitoring.WebhookQueueLength.With(prometheus.Labels{"type": "message"}).Sub(float64(msgCount))
-				monitoring.WebhookQueueLength.With(prometheus.Labels{"type": "status"}).Sub(float64(staCount))
-
-				w.Log.Info("Webhook succeeded", "url", w.URL, "status_code", code)
+	for _, t := range w.Targets {
+		t.Run(done, errors)
+	}
 
-				for _, msg := range whReq.Messages {
-					model.ReleaseMessage(msg)
-				}
-				for _, s := range whReq.Statuses {
-					model.ReleaseStatus(s)
-				}
-				ReleaseWebhookRequest(whReq)
-			}
-		}
+	go func() {
+		<-stop
+		stopStatus <- 1
+		close(done)
 	}()
 	return
 }